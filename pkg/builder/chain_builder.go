@@ -0,0 +1,110 @@
+package builder
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+)
+
+// ChainBuilder is an order builder bound to a signer and to an exchange contract of a chain,
+// so its methods don't take the signer and the contract. It targets the CTFExchange,
+// WithContract returns a builder for another exchange. The contract-taking API
+// stays available through Builder.
+type ChainBuilder struct {
+	builder  *ExchangeOrderBuilderImpl
+	signer   Signer
+	contract model.VerifyingContract
+}
+
+// NewMainnetBuilder creates a builder signing with the signer for the Polygon mainnet CTFExchange,
+// options are applied after the chain config
+func NewMainnetBuilder(s Signer, opts ...Option) *ChainBuilder {
+	return NewChainBuilder(model.MainnetChainConfig(), s, opts...)
+}
+
+// NewAmoyBuilder creates a builder signing with the signer for the Polygon Amoy testnet CTFExchange,
+// options are applied after the chain config
+func NewAmoyBuilder(s Signer, opts ...Option) *ChainBuilder {
+	return NewChainBuilder(model.AmoyChainConfig(), s, opts...)
+}
+
+// NewChainBuilder creates a builder signing with the signer for the CTFExchange of the chain,
+// options are applied after the chain config
+func NewChainBuilder(chainConfig *model.ChainConfig, s Signer, opts ...Option) *ChainBuilder {
+	return &ChainBuilder{
+		builder:  NewExchangeOrderBuilder(append([]Option{WithChainConfig(chainConfig)}, opts...)...),
+		signer:   s,
+		contract: model.CTFExchange,
+	}
+}
+
+// WithContract returns a builder sharing the signer and the configuration, bound to the contract,
+// e.g model.NegRiskCTFExchange for negative risk markets
+func (b *ChainBuilder) WithContract(contract model.VerifyingContract) *ChainBuilder {
+	c := *b
+	c.contract = contract
+	return &c
+}
+
+// Builder returns the underlying builder, taking the signer and the contract on each call
+func (b *ChainBuilder) Builder() *ExchangeOrderBuilderImpl {
+	return b.builder
+}
+
+// ChainID returns the id of the chain the orders are signed for
+func (b *ChainBuilder) ChainID() *big.Int {
+	return new(big.Int).Set(b.builder.chainConfig.ChainID)
+}
+
+// Contract returns the exchange contract the orders are signed for
+func (b *ChainBuilder) Contract() model.VerifyingContract {
+	return b.contract
+}
+
+// ExchangeAddress returns the address of the exchange contract the orders are signed for
+func (b *ChainBuilder) ExchangeAddress() (common.Address, error) {
+	return b.builder.chainConfig.VerifyingContractAddress(b.contract)
+}
+
+// Signer returns the signer of the orders
+func (b *ChainBuilder) Signer() Signer {
+	return b.signer
+}
+
+// BuildSignedOrder builds and signs the order, see ExchangeOrderBuilderImpl.BuildSignedOrder
+func (b *ChainBuilder) BuildSignedOrder(orderData *model.OrderData) (*model.SignedOrder, error) {
+	return b.builder.BuildSignedOrder(b.signer, orderData, b.contract)
+}
+
+// BuildSignedOrderContext builds and signs the order, see ExchangeOrderBuilderImpl.BuildSignedOrderContext
+func (b *ChainBuilder) BuildSignedOrderContext(ctx context.Context, orderData *model.OrderData) (*model.SignedOrder, error) {
+	return b.builder.BuildSignedOrderContext(ctx, b.signer, orderData, b.contract)
+}
+
+// BuildSignedOrders builds and signs a batch of orders, see ExchangeOrderBuilderImpl.BuildSignedOrders
+func (b *ChainBuilder) BuildSignedOrders(orders []*model.OrderData) ([]*model.SignedOrder, error) {
+	return b.builder.BuildSignedOrders(b.signer, orders, b.contract)
+}
+
+// BuildOrderHash generates the hash of the order, see ExchangeOrderBuilderImpl.BuildOrderHash
+func (b *ChainBuilder) BuildOrderHash(order *model.Order) (model.OrderHash, error) {
+	return b.builder.BuildOrderHash(order, b.contract)
+}
+
+// OrderID builds the order and generates its hash without signing, see ExchangeOrderBuilderImpl.OrderID.
+// The maker and signer are not filled in from the signer of the builder.
+func (b *ChainBuilder) OrderID(orderData *model.OrderData) (model.OrderHash, error) {
+	return b.builder.OrderID(orderData, b.contract)
+}
+
+// VerifySignedOrder verifies the signature of the order, see ExchangeOrderBuilderImpl.VerifySignedOrder
+func (b *ChainBuilder) VerifySignedOrder(order *model.SignedOrder) (bool, error) {
+	return b.builder.VerifySignedOrder(order, b.contract)
+}
+
+// DomainSeparator computes the EIP712 domain separator of the exchange contract
+func (b *ChainBuilder) DomainSeparator() (common.Hash, error) {
+	return b.builder.DomainSeparator(b.contract)
+}
@@ -0,0 +1,83 @@
+package builder
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/signer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMainnetBuilder(t *testing.T) {
+	ethSigner := signer.NewPrivateKeySigner(privateKey)
+	b := NewMainnetBuilder(ethSigner)
+
+	assert.Equal(t, int64(137), b.ChainID().Int64())
+	assert.Equal(t, model.CTFExchange, b.Contract())
+	address, err := b.ExchangeAddress()
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E"), address)
+
+	domainSeparator, err := b.DomainSeparator()
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1a573e3617c78403b5b4b892827992f027b03d4eaf570048b8ee8cdd84d151be", domainSeparator.Hex())
+
+	negRisk := b.WithContract(model.NegRiskCTFExchange)
+	address, err = negRisk.ExchangeAddress()
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0xC5d563A36AE78145C45a50134d48A1215220f80a"), address)
+	domainSeparator, err = negRisk.DomainSeparator()
+	assert.NoError(t, err)
+	assert.Equal(t, "0x82cb6aa85babb812f4b521a12b10f0cbc68d2b44be7bc02c047004f544adb49f", domainSeparator.Hex())
+	// the original is still bound to the CTFExchange
+	assert.Equal(t, model.CTFExchange, b.Contract())
+}
+
+func TestNewAmoyBuilder(t *testing.T) {
+	ethSigner := signer.NewPrivateKeySigner(privateKey)
+	b := NewAmoyBuilder(ethSigner, WithSaltGenerator(func() *big.Int { return big.NewInt(salt) }))
+
+	assert.Equal(t, int64(80002), b.ChainID().Int64())
+	assert.Equal(t, ethSigner, b.Signer())
+
+	orderData := &model.OrderData{
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	// same orders as the contract-taking builder
+	signedOrder, err := b.BuildSignedOrder(orderData)
+	assert.NoError(t, err)
+	expected, err := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt }).BuildSignedOrder(ethSigner, orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, signedOrder)
+
+	orderHash, err := b.BuildOrderHash(&signedOrder.Order)
+	assert.NoError(t, err)
+	assert.Equal(t, signedOrder.OrderHash, orderHash)
+
+	// OrderID doesn't sign, the maker isn't filled in from the signer
+	orderData.Maker = signerAddress.Hex()
+	orderId, err := b.OrderID(orderData)
+	assert.NoError(t, err)
+	assert.Equal(t, signedOrder.OrderHash, orderId)
+
+	valid, err := b.VerifySignedOrder(signedOrder)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// signed for the CTFExchange, not valid on the NegRiskCTFExchange
+	valid, err = b.WithContract(model.NegRiskCTFExchange).VerifySignedOrder(signedOrder)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// the chain id is a copy
+	b.ChainID().SetInt64(1)
+	assert.Equal(t, int64(80002), b.ChainID().Int64())
+}
@@ -0,0 +1,189 @@
+package builder
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/ethsig/eip712"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+)
+
+// Message attested by the ClobAuth signature of the CLOB L1 authentication
+const ClobAuthMessage = "This message attests that I control the given wallet"
+
+// EIP712 types of the CLOB L1 authentication, the domain has no verifying contract
+var clobAuthTypes = eip712.Types{
+	"EIP712Domain": []eip712.Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+	},
+	"ClobAuth": []eip712.Type{
+		{Name: "address", Type: "address"},
+		{Name: "timestamp", Type: "string"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "message", Type: "string"},
+	},
+}
+
+// ApiCredentials are the CLOB API key credentials used for L2 authentication
+type ApiCredentials struct {
+	Key        string
+	Secret     string
+	Passphrase string
+}
+
+// L2Headers are the CLOB L2 authentication headers
+type L2Headers struct {
+	Address    string
+	Signature  string
+	Timestamp  string
+	ApiKey     string
+	Passphrase string
+}
+
+// Header converts the L2 headers into http headers
+func (h *L2Headers) Header() http.Header {
+	header := http.Header{}
+	header.Set("POLY_ADDRESS", h.Address)
+	header.Set("POLY_SIGNATURE", h.Signature)
+	header.Set("POLY_TIMESTAMP", h.Timestamp)
+	header.Set("POLY_API_KEY", h.ApiKey)
+	header.Set("POLY_PASSPHRASE", h.Passphrase)
+	return header
+}
+
+// CancelOrderRequest is an authenticated CLOB request cancelling a single order
+type CancelOrderRequest struct {
+	Method  string
+	Path    string
+	Body    []byte
+	Headers *L2Headers
+}
+
+// Builds the ClobAuth EIP712 typed data signed for the CLOB L1 authentication,
+// i.e to create or derive an API key.
+//
+// @param address - the address authenticating, the signer must sign for it
+//
+// @param timestamp - the unix timestamp of the request, in seconds
+//
+// @param nonce - the nonce of the API key, 0 unless several keys are derived
+//
+// @returns the ClobAuth typed data
+func BuildClobAuthTypedData(address common.Address, timestamp, nonce int64, chainID int64) eip712.TypedData {
+	return eip712.TypedData{
+		Types:       clobAuthTypes,
+		PrimaryType: "ClobAuth",
+		Domain: eip712.TypedDataDomain{
+			Name:    "ClobAuthDomain",
+			Version: "1",
+			ChainId: strconv.FormatInt(chainID, 10),
+		},
+		Message: eip712.TypedDataMessage{
+			"address":   address.Hex(),
+			"timestamp": strconv.FormatInt(timestamp, 10),
+			"nonce":     strconv.FormatInt(nonce, 10),
+			"message":   ClobAuthMessage,
+		},
+	}
+}
+
+// Signs the ClobAuth typed data of the CLOB L1 authentication, sent as the POLY_SIGNATURE header.
+// The signature is normalized to its canonical low-S form.
+//
+// @param signer - the signer instance to use for signing
+//
+// @param address - the address authenticating, the signer must sign for it
+//
+// @param timestamp - the unix timestamp of the request, in seconds
+//
+// @param nonce - the nonce of the API key
+//
+// @returns the signature
+func BuildClobAuthSignature(s Signer, address common.Address, timestamp, nonce int64, chainID int64) (model.OrderSignature, error) {
+	signature, err := s.SignTypedData(BuildClobAuthTypedData(address, timestamp, nonce, chainID))
+	if err != nil {
+		return nil, err
+	}
+
+	return model.NormalizeSignature(signature)
+}
+
+// Builds the HMAC signature of a CLOB L2 request.
+//
+// @param secret - the url safe base64 encoded API secret
+//
+// @returns the url safe base64 encoded HMAC-SHA256 of timestamp + method + requestPath + body
+func BuildL2Signature(secret string, timestamp int64, method, requestPath string, body []byte) (string, error) {
+	key, err := base64.URLEncoding.DecodeString(secret)
+	if err != nil {
+		return "", fmt.Errorf("can't decode secret as url safe base64: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + method + requestPath))
+	mac.Write(body)
+
+	return base64.URLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Builds the L2 headers of a CLOB request.
+//
+// @param address - the address owning the API key
+//
+// @returns the L2Headers
+func BuildL2Headers(creds ApiCredentials, address common.Address, timestamp int64, method, requestPath string, body []byte) (*L2Headers, error) {
+	signature, err := BuildL2Signature(creds.Secret, timestamp, method, requestPath, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &L2Headers{
+		Address:    address.Hex(),
+		Signature:  signature,
+		Timestamp:  strconv.FormatInt(timestamp, 10),
+		ApiKey:     creds.Key,
+		Passphrase: creds.Passphrase,
+	}, nil
+}
+
+// Builds the request cancelling an order by hash.
+//
+// Polymarket has no EIP712 cancel message: the CLOB cancels an order by its hash
+// with a `DELETE /order` request authenticated by the L2 (API key HMAC) headers.
+// Onchain cancellations go through the exchange's cancelOrder and are not signed messages either.
+//
+// @param address - the address owning the API key
+//
+// @param orderHash - the hash of the order to cancel, i.e the order ID
+//
+// @returns a CancelOrderRequest to send to the CLOB
+func BuildCancelOrderRequest(creds ApiCredentials, address common.Address, orderHash model.OrderHash, timestamp int64) (*CancelOrderRequest, error) {
+	body, err := json.Marshal(struct {
+		OrderID string `json:"orderID"`
+	}{
+		OrderID: orderHash.Hex(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := BuildL2Headers(creds, address, timestamp, http.MethodDelete, "/order", body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CancelOrderRequest{
+		Method:  http.MethodDelete,
+		Path:    "/order",
+		Body:    body,
+		Headers: headers,
+	}, nil
+}
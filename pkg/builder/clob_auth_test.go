@@ -0,0 +1,106 @@
+package builder
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/signer"
+	"github.com/stretchr/testify/assert"
+)
+
+var creds = ApiCredentials{
+	Key:        "00000000-0000-0000-0000-000000000000",
+	Secret:     "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=",
+	Passphrase: "passphrase",
+}
+
+func TestBuildL2Signature(t *testing.T) {
+	signature, err := BuildL2Signature(creds.Secret, 1700000000, "DELETE", "/order", []byte(`{"orderID":"0x02ca1d1aa31103804173ad1acd70066cb6c1258a4be6dada055111f9a7ea4e55"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "Kii8yYZgR4AuCjkO1INmOCWoNpSkHpPGE7pUZ_iwloQ=", signature)
+
+	// invalid secret
+	_, err = BuildL2Signature("not base64!", 1700000000, "DELETE", "/order", nil)
+	assert.Error(t, err)
+}
+
+func TestBuildCancelOrderRequest(t *testing.T) {
+	orderHash := common.HexToHash("02ca1d1aa31103804173ad1acd70066cb6c1258a4be6dada055111f9a7ea4e55")
+
+	request, err := BuildCancelOrderRequest(creds, signerAddress, orderHash, 1700000000)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "DELETE", request.Method)
+	assert.Equal(t, "/order", request.Path)
+	assert.Equal(t, `{"orderID":"0x02ca1d1aa31103804173ad1acd70066cb6c1258a4be6dada055111f9a7ea4e55"}`, string(request.Body))
+
+	assert.Equal(t, signerAddress.Hex(), request.Headers.Address)
+	assert.Equal(t, "1700000000", request.Headers.Timestamp)
+	assert.Equal(t, creds.Key, request.Headers.ApiKey)
+	assert.Equal(t, creds.Passphrase, request.Headers.Passphrase)
+	assert.Equal(t, "Kii8yYZgR4AuCjkO1INmOCWoNpSkHpPGE7pUZ_iwloQ=", request.Headers.Signature)
+
+	// the server recomputes the HMAC from the request
+	key, _ := base64.URLEncoding.DecodeString(creds.Secret)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(request.Headers.Timestamp + request.Method + request.Path + string(request.Body)))
+	assert.Equal(t, base64.URLEncoding.EncodeToString(mac.Sum(nil)), request.Headers.Signature)
+
+	header := request.Headers.Header()
+	assert.Equal(t, signerAddress.Hex(), header.Get("POLY_ADDRESS"))
+	assert.Equal(t, request.Headers.Signature, header.Get("POLY_SIGNATURE"))
+	assert.Equal(t, "1700000000", header.Get("POLY_TIMESTAMP"))
+	assert.Equal(t, creds.Key, header.Get("POLY_API_KEY"))
+	assert.Equal(t, creds.Passphrase, header.Get("POLY_PASSPHRASE"))
+}
+
+func TestBuildClobAuthSignature(t *testing.T) {
+	ethSigner := signer.NewPrivateKeySigner(privateKey)
+
+	// same signature as the reference CLOB clients
+	signature, err := BuildClobAuthSignature(ethSigner, signerAddress, 10000000, 23, model.AmoyChainId)
+	assert.NoError(t, err)
+	assert.Equal(t, "f62319a987514da40e57e2f4d7529f7bac38f0355bd88bb5adbb3768d80de6c1682518e0af677d5260366425f4361e7b70c25ae232aff0ab2331e2b164a1aedc1b", common.Bytes2Hex(signature))
+
+	// the signature recovers to the key over the digest of go-ethereum's typed data
+	digest, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"ClobAuth": {
+				{Name: "address", Type: "address"},
+				{Name: "timestamp", Type: "string"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "message", Type: "string"},
+			},
+		},
+		PrimaryType: "ClobAuth",
+		Domain: apitypes.TypedDataDomain{
+			Name:    "ClobAuthDomain",
+			Version: "1",
+			ChainId: math.NewHexOrDecimal256(model.PolygonChainId),
+		},
+		Message: apitypes.TypedDataMessage{
+			"address":   signerAddress.Hex(),
+			"timestamp": "1700000000",
+			"nonce":     "0",
+			"message":   "This message attests that I control the given wallet",
+		},
+	})
+	assert.NoError(t, err)
+
+	signature, err = BuildClobAuthSignature(ethSigner, signerAddress, 1700000000, 0, model.PolygonChainId)
+	assert.NoError(t, err)
+	recovered, err := recoverAddress(common.BytesToHash(digest), signature)
+	assert.NoError(t, err)
+	assert.Equal(t, signerAddress, recovered)
+}
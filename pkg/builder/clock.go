@@ -0,0 +1,14 @@
+package builder
+
+import "time"
+
+// Clock provides the current time to the builder, e.g to validate order expirations
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,34 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+)
+
+// BuildError reports an order of a batch that failed to build, with the context known
+// when it failed. BuildSignedOrders joins a BuildError per failing order.
+type BuildError struct {
+	// Index of the order data in the batch
+	Index int
+
+	// Hash of the order, zero if the order failed before it was hashed
+	OrderHash model.OrderHash
+
+	// Signer of the order, i.e the address the order is signed with
+	Signer common.Address
+
+	Err error
+}
+
+func (e *BuildError) Error() string {
+	if e.OrderHash == (model.OrderHash{}) {
+		return fmt.Sprintf("failed to build order %d: %v", e.Index, e.Err)
+	}
+	return fmt.Sprintf("failed to build order %d (hash %s, signer %s): %v", e.Index, e.OrderHash.Hex(), e.Signer.Hex(), e.Err)
+}
+
+func (e *BuildError) Unwrap() error {
+	return e.Err
+}
@@ -1,10 +1,14 @@
 package builder
 
 import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
 )
 
-//go:generate mockery --name ExchangeOrderBuilder
+//go:generate mockery --name ExchangeOrderBuilder --with-expecter
 type ExchangeOrderBuilder interface {
 	// build an order object including the signature.
 	//
@@ -15,6 +19,59 @@ type ExchangeOrderBuilder interface {
 	// @returns a SignedOrder object (order + signature)
 	BuildSignedOrder(signer Signer, orderData *model.OrderData, contract model.VerifyingContract) (*model.SignedOrder, error)
 
+	// build an order object including the signature, and return the order hash along with it.
+	//
+	// @param signer - the signer instance to use for signing
+	//
+	// @param orderData
+	//
+	// @returns a SignedOrder object (order + signature) and the order hash
+	BuildSignedOrderWithHash(signer Signer, orderData *model.OrderData, contract model.VerifyingContract) (*model.SignedOrder, model.OrderHash, error)
+
+	// build an order object including the signature, the signing can be cancelled through the context.
+	//
+	// @param ctx
+	//
+	// @param signer - the signer instance to use for signing
+	//
+	// @param orderData
+	//
+	// @returns a SignedOrder object (order + signature), or ctx.Err() once the context is done
+	BuildSignedOrderContext(ctx context.Context, signer Signer, orderData *model.OrderData, contract model.VerifyingContract) (*model.SignedOrder, error)
+
+	// build a limit order from its price and size including the signature.
+	// The price is rounded to the tick size and the amounts are derived from it.
+	//
+	// @param signer - the signer instance to use for signing
+	//
+	// @param params - the price, size and fields of the order
+	//
+	// @returns a SignedOrder object (order + signature)
+	BuildOrderFromPrice(signer Signer, params model.PriceOrderParams, contract model.VerifyingContract) (*model.SignedOrder, error)
+
+	// build a batch of order objects including the signatures.
+	// The domain separator is computed once and the orders are signed concurrently,
+	// so the signer must be safe for concurrent use.
+	//
+	// @param signer - the signer instance to use for signing
+	//
+	// @param orders
+	//
+	// @returns the SignedOrder objects in the same order as the order data.
+	// If an order fails, the successfully signed orders are still returned
+	// and the error joins a *BuildError per failing order, by index.
+	BuildSignedOrders(signer Signer, orders []*model.OrderData, contract model.VerifyingContract) ([]*model.SignedOrder, error)
+
+	// build a batch of order objects including the signatures, each against its own exchange contract.
+	// The domain separator of each distinct contract is computed once.
+	//
+	// @param signer - the signer instance to use for signing
+	//
+	// @param items - the order data and the contract of each order
+	//
+	// @returns the SignedOrder objects in the same order as the items, see BuildSignedOrders.
+	BuildSignedOrdersMulti(signer Signer, items []OrderBatchItem) ([]*model.SignedOrder, error)
+
 	// Creates an Order object from order data.
 	//
 	// @param orderData
@@ -22,6 +79,14 @@ type ExchangeOrderBuilder interface {
 	// @returns a Order object (not signed)
 	BuildOrder(orderData *model.OrderData) (*model.Order, error)
 
+	// Creates an Order object from market order data, deriving the maker and taker amounts
+	// from the human readable amount and price.
+	//
+	// @param orderData
+	//
+	// @returns a Order object (not signed)
+	BuildMarketOrder(orderData *model.MarketOrderData, contract model.VerifyingContract) (*model.Order, error)
+
 	// Generates the hash of the order from a EIP712TypedData object.
 	//
 	// @param Order
@@ -29,6 +94,104 @@ type ExchangeOrderBuilder interface {
 	// @returns a OrderHash that is a 'common.Hash'
 	BuildOrderHash(order *model.Order, contract model.VerifyingContract) (model.OrderHash, error)
 
+	// Generates the EIP712 struct hash of the order, i.e hashStruct(order) without the domain.
+	// BuildOrderHash is keccak256("\x19\x01" ‖ domainSeparator ‖ structHash).
+	//
+	// @param order
+	//
+	// @returns the struct hash as 'common.Hash'
+	OrderStructHash(order *model.Order) (common.Hash, error)
+
+	// Generates the hashes of a batch of orders, computing the domain separator once.
+	//
+	// @param orders
+	//
+	// @returns the OrderHashes in the same order as the orders
+	BuildOrderHashes(orders []*model.Order, contract model.VerifyingContract) ([]model.OrderHash, error)
+
+	// Compares the hashes of both orders for the exchange.
+	//
+	// @param a
+	//
+	// @param b
+	//
+	// @returns true if both orders have the same hash
+	SameHash(a, b *model.Order, contract model.VerifyingContract) (bool, error)
+
+	// Builds the EIP712 typed data of the order, e.g to be signed by a wallet
+	// through eth_signTypedData_v4.
+	//
+	// @param order
+	//
+	// @returns the go-ethereum apitypes.TypedData of the order
+	BuildOrderTypedData(order *model.Order, contract model.VerifyingContract) (*apitypes.TypedData, error)
+
+	// Builds the JSON typed data of the order expected by eth_signTypedData_v4.
+	//
+	// @param order
+	//
+	// @returns the JSON encoding of the typed data
+	BuildOrderTypedDataJSON(order *model.Order, contract model.VerifyingContract) ([]byte, error)
+
+	// Builds the order from order data and reports all the values derived from it, without signing.
+	//
+	// @param orderData
+	//
+	// @returns the OrderExplanation of the order
+	Explain(orderData *model.OrderData, contract model.VerifyingContract) (*model.OrderExplanation, error)
+
+	// Builds the order from order data and generates its hash without signing.
+	//
+	// @param orderData
+	//
+	// @returns a OrderHash that is a 'common.Hash'
+	OrderID(orderData *model.OrderData, contract model.VerifyingContract) (model.OrderHash, error)
+
+	// Verifies that the signature of the order recovers to the order signer.
+	// For POLY_PROXY and POLY_GNOSIS_SAFE orders this is the EOA signer, not the maker.
+	//
+	// @param order - the signed order, its hash is rebuilt from the order fields
+	//
+	// @returns true if the signature was produced by the order signer
+	VerifySignedOrder(order *model.SignedOrder, contract model.VerifyingContract) (bool, error)
+
+	// Verifies the signature of the order and that the order carries the expected fields.
+	//
+	// @param order - the signed order, its hash is rebuilt from the order fields
+	//
+	// @param expected - the expected fields, nil fields are skipped
+	//
+	// @returns nil if the order is valid, model.ErrInvalidSignature if it was not signed by the order signer,
+	// or a *model.OrderMismatchError naming the first mismatching field
+	VerifyOrderMatches(order *model.SignedOrder, expected model.OrderExpectation, contract model.VerifyingContract) error
+
+	// Wraps an order and a signature produced elsewhere into a signed order,
+	// the signature is validated against the order hash.
+	//
+	// @param order
+	//
+	// @param signature - a 65 bytes signature of the order hash
+	//
+	// @returns a SignedOrder object (order + signature), or model.ErrInvalidSignature
+	// if the signature doesn't recover to the order signer
+	AttachSignature(order *model.Order, signature model.OrderSignature, contract model.VerifyingContract) (*model.SignedOrder, error)
+
+	// Recovers the address that signed the order.
+	//
+	// @param order
+	//
+	// @param signature - a 65 bytes signature, the recovery id can be either 0/1 or 27/28
+	//
+	// @returns the address of the signer
+	RecoverOrderSigner(order *model.Order, signature model.OrderSignature, contract model.VerifyingContract) (common.Address, error)
+
+	// Computes the EIP712 domain separator of the exchange contract.
+	//
+	// @param contract
+	//
+	// @returns the domain separator as 'common.Hash', equal to the contract's DOMAIN_SEPARATOR()
+	DomainSeparator(contract model.VerifyingContract) (common.Hash, error)
+
 	// signs an order
 	//
 	// @param signer - the signer instance to use for signing
@@ -47,3 +210,9 @@ type ExchangeOrderBuilder interface {
 	// @returns a OrderSignature that is []byte
 	// BuildOrderSignature(signer signer.Signer, orderHash model.OrderHash) (model.OrderSignature, error)
 }
+
+// OrderBatchItem is an order of a BuildSignedOrdersMulti batch and the exchange contract it is signed for
+type OrderBatchItem struct {
+	Data     *model.OrderData
+	Contract model.VerifyingContract
+}
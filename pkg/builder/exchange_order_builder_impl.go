@@ -1,35 +1,96 @@
 package builder
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
+	"runtime"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ivanzzeth/ethsig"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/ivanzzeth/ethsig/eip712"
 	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/signer"
 	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/utils"
+	"github.com/shopspring/decimal"
 )
 
+// ExchangeOrderBuilderImpl is safe for concurrent use: its configuration is immutable
+// once built and the order data passed in is never mutated. Custom salt generators,
+// clocks and signers shared between goroutines must be safe for concurrent use too,
+// the default ones are.
 type ExchangeOrderBuilderImpl struct {
-	chainId       *big.Int
-	saltGenerator func() int64
+	chainConfig   *model.ChainConfig
+	saltGenerator SaltGenerator
+	tickSize      decimal.Decimal
+	clock         Clock
+
+	// overrides the max fee rate of the chain config when set
+	maxFeeRateBps *int64
+
+	contractSignaturePacker ContractSignaturePacker
+	orderBuiltHook          OrderBuiltHook
+
+	// optional, see WithSignatureCache
+	signatureCache *signatureCache
+
+	// model.VerifyingContract -> cachedDomain
+	domainSeparators sync.Map
 }
 
 var _ ExchangeOrderBuilder = (*ExchangeOrderBuilderImpl)(nil)
 
-func NewExchangeOrderBuilderImpl(chainId *big.Int, saltGenerator func() int64) *ExchangeOrderBuilderImpl {
-	if saltGenerator == nil {
-		saltGenerator = utils.GenerateRandomSalt
+// NewExchangeOrderBuilder creates an order builder configured by the options.
+// Without options, the builder targets Polygon mainnet and draws random salts.
+func NewExchangeOrderBuilder(opts ...Option) *ExchangeOrderBuilderImpl {
+	e := &ExchangeOrderBuilderImpl{
+		chainConfig:   model.MainnetChainConfig(),
+		saltGenerator: randomSaltGenerator,
+		clock:         realClock{},
 	}
-	return &ExchangeOrderBuilderImpl{
-		chainId:       chainId,
-		saltGenerator: saltGenerator,
+	for _, opt := range opts {
+		opt(e)
 	}
+	return e
+}
+
+// NewExchangeOrderBuilderImpl creates an order builder for the chain id,
+// options are applied after the chain config and the salt generator.
+func NewExchangeOrderBuilderImpl(chainId *big.Int, saltGenerator func() int64, opts ...Option) *ExchangeOrderBuilderImpl {
+	// unknown chains fail when resolving the verifying contract
+	chainConfig := &model.ChainConfig{ChainID: chainId}
+	if chainId != nil {
+		if c, err := model.GetChainConfig(chainId.Int64()); err == nil {
+			chainConfig = c
+		}
+	}
+
+	baseOpts := []Option{WithChainConfig(chainConfig)}
+	if saltGenerator != nil {
+		baseOpts = append(baseOpts, WithSaltGenerator(func() *big.Int {
+			return new(big.Int).SetInt64(saltGenerator())
+		}))
+	}
+
+	return NewExchangeOrderBuilder(append(baseOpts, opts...)...)
+}
+
+func randomSaltGenerator() *big.Int {
+	return new(big.Int).SetInt64(utils.GenerateRandomSalt())
 }
 
 // build an order object including the signature.
+// Orders without maker are filled with the signer, or the wallet of the signer for POLY_PROXY
+// and POLY_GNOSIS_SAFE orders. The maker and signer of EOA orders default to the signer address
+// and are rejected with model.ErrInvalidSigner if set to another address.
+// The signature must recover to both the order signer and the GetAddress of the signer,
+// model.ErrInvalidSignature is returned otherwise.
+// A signer.SignerSelector signs with the signer its Next picks.
 //
 // @param signer - the signer instance to use for signing
 //
@@ -37,49 +98,236 @@ func NewExchangeOrderBuilderImpl(chainId *big.Int, saltGenerator func() int64) *
 //
 // @returns a SignedOrder object (order + signature)
 func (e *ExchangeOrderBuilderImpl) BuildSignedOrder(s Signer, orderData *model.OrderData, contract model.VerifyingContract) (*model.SignedOrder, error) {
-	order, err := e.BuildOrder(orderData)
+	signedOrder, _, err := e.BuildSignedOrderWithHash(s, orderData, contract)
+	return signedOrder, err
+}
+
+// build an order object including the signature, and return the order hash along with it.
+// The digest is computed once and signers implementing signer.HashContextSigner sign it directly.
+//
+// @param signer - the signer instance to use for signing
+//
+// @param orderData
+//
+// @returns a SignedOrder object (order + signature) and the order hash
+func (e *ExchangeOrderBuilderImpl) BuildSignedOrderWithHash(s Signer, orderData *model.OrderData, contract model.VerifyingContract) (*model.SignedOrder, model.OrderHash, error) {
+	signedOrder, err := e.BuildSignedOrderContext(context.Background(), s, orderData, contract)
+	if err != nil {
+		return nil, model.OrderHash{}, err
+	}
+
+	return signedOrder, signedOrder.OrderHash, nil
+}
+
+// build an order object including the signature, the signing can be cancelled through the context.
+// Signers implementing signer.ContextSigner are interrupted when the context is done,
+// other signers are only checked before signing.
+//
+// @param ctx
+//
+// @param signer - the signer instance to use for signing
+//
+// @param orderData
+//
+// @returns a SignedOrder object (order + signature), or ctx.Err() once the context is done
+func (e *ExchangeOrderBuilderImpl) BuildSignedOrderContext(ctx context.Context, s Signer, orderData *model.OrderData, contract model.VerifyingContract) (*model.SignedOrder, error) {
+	domain, domainSeparator, err := e.buildDomainSeparator(contract)
 	if err != nil {
 		return nil, err
 	}
 
-	signature, err := e.BuildOrderSignature(s, order, contract)
+	signedOrder, err := e.buildSignedOrder(ctx, s, orderData, domain, domainSeparator)
 	if err != nil {
+		// the context of a BuildError only matters in a batch
+		var buildErr *BuildError
+		if errors.As(err, &buildErr) {
+			return nil, buildErr.Err
+		}
 		return nil, err
 	}
 
-	// Validate the signature
-	orderHash, err := e.BuildOrderHash(order, contract)
+	return signedOrder, nil
+}
+
+// build a limit order from its price and size including the signature.
+// The price is rounded to the nearest tick and the maker and taker amounts are derived
+// with model.CalcOrderAmounts, so the implied price of the order is exactly the rounded price.
+//
+// @param signer - the signer instance to use for signing
+//
+// @param params - the price, size and fields of the order
+//
+// @returns a SignedOrder object (order + signature)
+func (e *ExchangeOrderBuilderImpl) BuildOrderFromPrice(s Signer, params model.PriceOrderParams, contract model.VerifyingContract) (*model.SignedOrder, error) {
+	price, err := decimal.NewFromString(params.Price)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse Price: %s as valid decimal", params.Price)
+	}
+
+	size, err := decimal.NewFromString(params.Size)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse Size: %s as valid decimal", params.Size)
+	}
+
+	price = model.RoundToTickSize(price, e.tickSize)
+
+	makerAmount, takerAmount, err := e.chainConfig.TokenDecimals().CalcOrderAmounts(params.Side, price, size)
 	if err != nil {
 		return nil, err
 	}
 
-	ok, err := ethsig.ValidateSignature(order.Signer, orderHash, signature)
+	return e.BuildSignedOrder(s, &model.OrderData{
+		Maker:         params.Maker,
+		Taker:         params.Taker,
+		TokenId:       params.TokenId,
+		MakerAmount:   makerAmount.String(),
+		TakerAmount:   takerAmount.String(),
+		FeeRateBps:    params.FeeRateBps,
+		Nonce:         params.Nonce,
+		Signer:        params.Signer,
+		Expiration:    params.Expiration,
+		Side:          params.Side,
+		SignatureType: params.SignatureType,
+	}, contract)
+}
+
+// build a batch of order objects including the signatures.
+// The domain separator is computed once and the orders are signed concurrently,
+// so the signer must be safe for concurrent use.
+//
+// @param signer - the signer instance to use for signing
+//
+// @param orders
+//
+// @returns the SignedOrder objects in the same order as the order data.
+// If an order fails, the successfully signed orders are still returned
+// and the error joins a *BuildError per failing order, by index.
+func (e *ExchangeOrderBuilderImpl) BuildSignedOrders(s Signer, orders []*model.OrderData, contract model.VerifyingContract) ([]*model.SignedOrder, error) {
+	domain, domainSeparator, err := e.buildDomainSeparator(contract)
 	if err != nil {
 		return nil, err
 	}
-	if !ok {
-		return nil, fmt.Errorf("signature error")
+
+	return buildBatch(len(orders), func(i int) (*model.SignedOrder, error) {
+		return e.buildSignedOrder(context.Background(), s, orders[i], domain, domainSeparator)
+	})
+}
+
+// build a batch of order objects including the signatures, each against its own exchange contract.
+// The domain separator of each distinct contract is computed once and the orders are signed
+// concurrently, so the signer must be safe for concurrent use.
+//
+// @param signer - the signer instance to use for signing
+//
+// @param items - the order data and the contract of each order
+//
+// @returns the SignedOrder objects in the same order as the items, see BuildSignedOrders.
+// An invalid contract fails the batch before signing with a *BuildError of its index.
+func (e *ExchangeOrderBuilderImpl) BuildSignedOrdersMulti(s Signer, items []OrderBatchItem) ([]*model.SignedOrder, error) {
+	domains := make([]eip712.TypedDataDomain, len(items))
+	domainSeparators := make([]common.Hash, len(items))
+	for i, item := range items {
+		domain, domainSeparator, err := e.buildDomainSeparator(item.Contract)
+		if err != nil {
+			return nil, &BuildError{Index: i, Err: err}
+		}
+		domains[i], domainSeparators[i] = domain, domainSeparator
 	}
 
-	return &model.SignedOrder{
-		Order:     *order,
-		Signature: signature,
-		OrderHash: orderHash,
-	}, nil
+	return buildBatch(len(items), func(i int) (*model.SignedOrder, error) {
+		return e.buildSignedOrder(context.Background(), s, items[i].Data, domains[i], domainSeparators[i])
+	})
+}
+
+// buildBatch builds the orders 0..n-1 concurrently.
+// The errors of the failing orders are joined, their *BuildError carrying the index.
+func buildBatch(n int, build func(i int) (*model.SignedOrder, error)) ([]*model.SignedOrder, error) {
+	signedOrders := make([]*model.SignedOrder, n)
+	errs := make([]error, n)
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > n {
+		workers = n
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				signedOrders[i], errs[i] = build(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var buildErrs []error
+	for i, err := range errs {
+		var buildErr *BuildError
+		if errors.As(err, &buildErr) {
+			buildErr.Index = i
+			buildErrs = append(buildErrs, buildErr)
+		}
+	}
+
+	return signedOrders, errors.Join(buildErrs...)
 }
 
 // Creates an Order object from order data.
+// The order built hook gets the order hash for the CTF exchange.
 //
 // @param orderData
 //
 // @returns a Order object (not signed)
 func (e *ExchangeOrderBuilderImpl) BuildOrder(orderData *model.OrderData) (*model.Order, error) {
+	order, err := e.buildOrder(orderData, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.orderBuiltHook != nil {
+		orderHash, err := e.BuildOrderHash(order, model.CTFExchange)
+		if err != nil {
+			return nil, err
+		}
+		e.orderBuilt(order, orderHash)
+	}
+
+	return order, nil
+}
+
+// maxFeeRate returns the fee rate cap of the orders, the market override or the chain's
+func (e *ExchangeOrderBuilderImpl) maxFeeRate() int64 {
+	if e.maxFeeRateBps != nil {
+		return *e.maxFeeRateBps
+	}
+	return e.chainConfig.MaxFeeRate()
+}
+
+func (e *ExchangeOrderBuilderImpl) buildOrder(orderData *model.OrderData, checkTickSize bool) (*model.Order, error) {
+	if err := e.chainConfig.TokenDecimals().ValidateOrderDataMaxFee(orderData, e.maxFeeRate()); err != nil {
+		return nil, err
+	}
+
 	var signer common.Address
 	if orderData.Signer == "" {
 		signer = common.HexToAddress(orderData.Maker)
 	} else {
 		signer = common.HexToAddress(orderData.Signer)
 	}
+	maker := common.HexToAddress(orderData.Maker)
+
+	salt := e.saltGenerator()
+	if salt == nil || salt.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidSalt, salt)
+	}
 
 	var tokenId *big.Int
 	var ok bool
@@ -97,12 +345,25 @@ func (e *ExchangeOrderBuilderImpl) BuildOrder(orderData *model.OrderData) (*mode
 		return nil, fmt.Errorf("can't parse TakerAmount: %s as valid *big.Int", orderData.TakerAmount)
 	}
 
+	if checkTickSize {
+		price := e.chainConfig.TokenDecimals().ImpliedPrice(orderData.Side, makerAmount, takerAmount)
+		if !model.IsPriceOnTick(price, e.tickSize) {
+			return nil, fmt.Errorf("%w: price %s, tick size %s", model.ErrPriceNotOnTick, price.String(), e.tickSize.String())
+		}
+	}
+
+	// the order data is not mutated, it may be shared between goroutines
+	rawExpiration := orderData.Expiration
+	if rawExpiration == "" {
+		rawExpiration = "0"
+	}
 	var expiration *big.Int
-	if orderData.Expiration == "" {
-		orderData.Expiration = "0"
+	if expiration, ok = new(big.Int).SetString(rawExpiration, 10); !ok {
+		return nil, fmt.Errorf("can't parse Expiration: %s as valid *big.Int", rawExpiration)
 	}
-	if expiration, ok = new(big.Int).SetString(orderData.Expiration, 10); !ok {
-		return nil, fmt.Errorf("can't parse Expiration: %s as valid *big.Int", orderData.Expiration)
+	// the exchange only rejects an order once block.timestamp > expiration, so an order expiring now is still valid
+	if expiration.Sign() != 0 && expiration.Cmp(big.NewInt(e.clock.Now().Unix())) < 0 {
+		return nil, fmt.Errorf("%w: expiration %s", model.ErrExpired, expiration.String())
 	}
 
 	var nonce *big.Int
@@ -116,134 +377,733 @@ func (e *ExchangeOrderBuilderImpl) BuildOrder(orderData *model.OrderData) (*mode
 	}
 
 	return &model.Order{
-		Salt:          new(big.Int).SetInt64(e.saltGenerator()),
-		Maker:         common.HexToAddress(orderData.Maker),
+		Salt:          new(big.Int).Set(salt),
+		Maker:         maker,
 		Taker:         common.HexToAddress(orderData.Taker),
 		Signer:        signer,
 		TokenId:       tokenId,
 		MakerAmount:   makerAmount,
 		TakerAmount:   takerAmount,
-		Side:          new(big.Int).SetInt64(int64(orderData.Side)),
+		Side:          orderData.Side.Int(),
 		Expiration:    expiration,
 		Nonce:         nonce,
 		FeeRateBps:    feeRateBps,
-		SignatureType: new(big.Int).SetInt64(int64(orderData.SignatureType)),
+		SignatureType: orderData.SignatureType.Int(),
 	}, nil
 }
 
-// Generates the hash of the order from a EIP712TypedData object.
+// Creates an Order object from market order data, deriving the maker and taker amounts
+// from the human readable amount and price.
+//
+// @param orderData
+//
+// @returns a Order object (not signed)
+func (e *ExchangeOrderBuilderImpl) BuildMarketOrder(orderData *model.MarketOrderData, contract model.VerifyingContract) (*model.Order, error) {
+	if _, err := e.chainConfig.VerifyingContractAddress(contract); err != nil {
+		return nil, err
+	}
+
+	amount, err := decimal.NewFromString(orderData.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse Amount: %s as valid decimal", orderData.Amount)
+	}
+
+	price, err := decimal.NewFromString(orderData.Price)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse Price: %s as valid decimal", orderData.Price)
+	}
+
+	if !model.IsPriceOnTick(price, e.tickSize) {
+		return nil, fmt.Errorf("%w: price %s, tick size %s", model.ErrPriceNotOnTick, price.String(), e.tickSize.String())
+	}
+
+	makerAmount, takerAmount, err := e.chainConfig.TokenDecimals().CalcMarketOrderAmounts(orderData.Side, amount, price)
+	if err != nil {
+		return nil, err
+	}
+
+	// The amounts are rounded down to the token decimals,
+	// so only the requested price is checked against the tick size
+	order, err := e.buildOrder(&model.OrderData{
+		Maker:         orderData.Maker,
+		Taker:         orderData.Taker,
+		TokenId:       orderData.TokenId,
+		MakerAmount:   makerAmount.String(),
+		TakerAmount:   takerAmount.String(),
+		FeeRateBps:    orderData.FeeRateBps,
+		Nonce:         orderData.Nonce,
+		Signer:        orderData.Signer,
+		Expiration:    "0",
+		Side:          orderData.Side,
+		SignatureType: orderData.SignatureType,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.orderBuiltHook != nil {
+		orderHash, err := e.BuildOrderHash(order, contract)
+		if err != nil {
+			return nil, err
+		}
+		e.orderBuilt(order, orderHash)
+	}
+
+	return order, nil
+}
+
+// Verifies that the signature of the order recovers to the order signer.
+// For POLY_PROXY and POLY_GNOSIS_SAFE orders this is the EOA signer, not the maker.
+// POLY_1271 orders return model.ErrContractSignature, their signature is validated
+// onchain by the wallet's isValidSignature.
+//
+// @param order - the signed order, its hash is rebuilt from the order fields
+//
+// @returns true if the signature was produced by the order signer
+func (e *ExchangeOrderBuilderImpl) VerifySignedOrder(order *model.SignedOrder, contract model.VerifyingContract) (bool, error) {
+	signer, err := e.RecoverOrderSigner(&order.Order, order.Signature, contract)
+	if err != nil {
+		return false, err
+	}
+
+	return signer == order.Signer, nil
+}
+
+// Verifies the signature of the order and that the order carries the expected fields,
+// e.g to catch tampering between building and submitting the order.
+//
+// @param order - the signed order, its hash is rebuilt from the order fields
+//
+// @param expected - the expected fields, nil fields are skipped
+//
+// @returns nil if the order is valid, model.ErrInvalidSignature if it was not signed by the order signer,
+// or a *model.OrderMismatchError naming the first mismatching field
+func (e *ExchangeOrderBuilderImpl) VerifyOrderMatches(order *model.SignedOrder, expected model.OrderExpectation, contract model.VerifyingContract) error {
+	valid, err := e.VerifySignedOrder(order, contract)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("%w: %s", model.ErrInvalidSignature, order.Signer.Hex())
+	}
+
+	return expected.Check(&order.Order)
+}
+
+// Wraps an order and a signature produced elsewhere, e.g by a wallet given the order digest,
+// into a signed order. The signature is validated against the order hash and normalized to
+// its canonical low-S form with a 27/28 recovery id. POLY_1271 signatures can't be validated
+// locally, they're attached as is and must already be in the format the wallet expects.
+//
+// @param order
+//
+// @param signature - a 65 bytes signature of the order hash, the recovery id can be either 0/1 or 27/28
+//
+// @returns a SignedOrder object (order + signature), or model.ErrInvalidSignature
+// if the signature doesn't recover to the order signer
+func (e *ExchangeOrderBuilderImpl) AttachSignature(order *model.Order, signature model.OrderSignature, contract model.VerifyingContract) (*model.SignedOrder, error) {
+	orderHash, err := e.BuildOrderHash(order, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.SignatureType != nil && order.SignatureType.Cmp(model.SignatureTypePoly1271.Int()) == 0 {
+		signature = common.CopyBytes(signature)
+	} else {
+		recovered, err := recoverAddress(orderHash, signature)
+		if err != nil {
+			return nil, err
+		}
+		if recovered != order.Signer {
+			return nil, fmt.Errorf("%w: recovered %s, order signer %s", model.ErrInvalidSignature, recovered.Hex(), order.Signer.Hex())
+		}
+
+		signature, err = model.NormalizeSignature(signature)
+		if err != nil {
+			return nil, err
+		}
+		if signature[crypto.RecoveryIDOffset] < 27 {
+			signature[crypto.RecoveryIDOffset] += 27
+		}
+	}
+
+	signedOrder := &model.SignedOrder{
+		Order:     *order,
+		Signature: signature,
+		OrderHash: orderHash,
+	}
+	e.orderBuilt(&signedOrder.Order, orderHash)
+
+	return signedOrder, nil
+}
+
+// Recovers the address that signed the order.
+//
+// @param order
+//
+// @param signature - a 65 bytes signature, the recovery id can be either 0/1 or 27/28
+//
+// @returns the address of the signer, or model.ErrContractSignature for POLY_1271 orders
+func (e *ExchangeOrderBuilderImpl) RecoverOrderSigner(order *model.Order, signature model.OrderSignature, contract model.VerifyingContract) (common.Address, error) {
+	if order.SignatureType != nil && order.SignatureType.Cmp(model.SignatureTypePoly1271.Int()) == 0 {
+		return common.Address{}, model.ErrContractSignature
+	}
+
+	orderHash, err := e.BuildOrderHash(order, contract)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return recoverAddress(orderHash, signature)
+}
+
+// Computes the EIP712 domain separator of the exchange contract.
+//
+// @param contract
+//
+// @returns the domain separator as 'common.Hash', equal to the contract's DOMAIN_SEPARATOR()
+func (e *ExchangeOrderBuilderImpl) DomainSeparator(contract model.VerifyingContract) (common.Hash, error) {
+	_, domainSeparator, err := e.buildDomainSeparator(contract)
+	return domainSeparator, err
+}
+
+// Generates the hash of the order from a EIP712TypedData object,
+// i.e the digest keccak256("\x19\x01" ‖ DomainSeparator(contract) ‖ OrderStructHash(order)).
 //
 // @param Order
 //
 // @returns a OrderHash that is a 'common.Hash'
 func (e *ExchangeOrderBuilderImpl) BuildOrderHash(order *model.Order, contract model.VerifyingContract) (model.OrderHash, error) {
-	// Get the verifying contract address
-	verifyingContract, err := utils.GetVerifyingContractAddress(e.chainId, contract)
+	domain, domainSeparator, err := e.buildDomainSeparator(contract)
 	if err != nil {
 		return common.Hash{}, err
 	}
 
-	// Build the EIP712 TypedData
-	typedData := eip712.TypedData{
-		Types: eip712.Types{
-			"EIP712Domain": []eip712.Type{
-				{Name: "name", Type: "string"},
-				{Name: "version", Type: "string"},
-				{Name: "chainId", Type: "uint256"},
-				{Name: "verifyingContract", Type: "address"},
-			},
-			"Order": []eip712.Type{
-				{Name: "salt", Type: "uint256"},
-				{Name: "maker", Type: "address"},
-				{Name: "signer", Type: "address"},
-				{Name: "taker", Type: "address"},
-				{Name: "tokenId", Type: "uint256"},
-				{Name: "makerAmount", Type: "uint256"},
-				{Name: "takerAmount", Type: "uint256"},
-				{Name: "expiration", Type: "uint256"},
-				{Name: "nonce", Type: "uint256"},
-				{Name: "feeRateBps", Type: "uint256"},
-				{Name: "side", Type: "uint8"},
-				{Name: "signatureType", Type: "uint8"},
-			},
-		},
-		PrimaryType: "Order",
-		Domain: eip712.TypedDataDomain{
-			Name:              "Polymarket CTF Exchange",
-			Version:           "1",
-			ChainId:           e.chainId.String(),
-			VerifyingContract: verifyingContract.Hex(),
+	typedData, err := buildOrderTypedData(order, domain)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return hashOrder(typedData, domainSeparator)
+}
+
+// Generates the EIP712 struct hash of the order, i.e hashStruct(order) without the domain.
+// The order hash signed by the maker is the digest keccak256("\x19\x01" ‖ domainSeparator ‖ structHash),
+// as returned by BuildOrderHash. External signing tools sometimes expect both parts separately.
+//
+// @param order
+//
+// @returns the struct hash as 'common.Hash', independent of the chain and the exchange
+func (e *ExchangeOrderBuilderImpl) OrderStructHash(order *model.Order) (common.Hash, error) {
+	// the typed data must carry a domain to be hashed, though it is not part of the struct hash
+	domain, err := e.buildDomain(model.CTFExchange)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	typedData, err := buildOrderTypedData(order, domain)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return hashOrderStruct(typedData)
+}
+
+// Generates the hashes of a batch of orders.
+// The domain separator is computed once for the whole batch.
+//
+// @param orders
+//
+// @returns the OrderHashes in the same order as the orders,
+// the error identifies the index of the first malformed order
+func (e *ExchangeOrderBuilderImpl) BuildOrderHashes(orders []*model.Order, contract model.VerifyingContract) ([]model.OrderHash, error) {
+	domain, domainSeparator, err := e.buildDomainSeparator(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	orderHashes := make([]model.OrderHash, len(orders))
+	for i, order := range orders {
+		if order == nil {
+			return nil, fmt.Errorf("failed to hash order %d: order is nil", i)
+		}
+
+		typedData, err := buildOrderTypedData(order, domain)
+		if err == nil {
+			orderHashes[i], err = hashOrder(typedData, domainSeparator)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash order %d: %w", i, err)
+		}
+	}
+
+	return orderHashes, nil
+}
+
+// Compares the hashes of both orders for the exchange.
+//
+// @param a
+//
+// @param b
+//
+// @returns true if both orders have the same hash
+func (e *ExchangeOrderBuilderImpl) SameHash(a, b *model.Order, contract model.VerifyingContract) (bool, error) {
+	hashA, err := e.BuildOrderHash(a, contract)
+	if err != nil {
+		return false, err
+	}
+
+	hashB, err := e.BuildOrderHash(b, contract)
+	if err != nil {
+		return false, err
+	}
+
+	return hashA == hashB, nil
+}
+
+// Builds the EIP712 typed data of the order, e.g to be signed by a wallet
+// through eth_signTypedData_v4. Hashing it reproduces BuildOrderHash.
+//
+// @param order
+//
+// @returns the go-ethereum apitypes.TypedData of the order
+func (e *ExchangeOrderBuilderImpl) BuildOrderTypedData(order *model.Order, contract model.VerifyingContract) (*apitypes.TypedData, error) {
+	domain, err := e.buildDomain(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	typedData, err := buildOrderTypedData(order, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	types := apitypes.Types{}
+	for name, fields := range typedData.Types {
+		for _, field := range fields {
+			types[name] = append(types[name], apitypes.Type{Name: field.Name, Type: field.Type})
+		}
+	}
+
+	message := apitypes.TypedDataMessage{}
+	for key, value := range typedData.Message {
+		message[key] = value
+	}
+
+	return &apitypes.TypedData{
+		Types:       types,
+		PrimaryType: typedData.PrimaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              domain.Name,
+			Version:           domain.Version,
+			ChainId:           (*math.HexOrDecimal256)(new(big.Int).Set(e.chainConfig.ChainID)),
+			VerifyingContract: domain.VerifyingContract,
 		},
-		Message: eip712.TypedDataMessage{
-			"salt":          order.Salt.String(),
-			"maker":         order.Maker.Hex(),
-			"signer":        order.Signer.Hex(),
-			"taker":         order.Taker.Hex(),
-			"tokenId":       order.TokenId.String(),
-			"makerAmount":   order.MakerAmount.String(),
-			"takerAmount":   order.TakerAmount.String(),
-			"expiration":    order.Expiration.String(),
-			"nonce":         order.Nonce.String(),
-			"feeRateBps":    order.FeeRateBps.String(),
-			"side":          fmt.Sprintf("%d", order.Side.Uint64()),
-			"signatureType": fmt.Sprintf("%d", order.SignatureType.Uint64()),
+		Message: message,
+	}, nil
+}
+
+// typedDataJSON is the typed data parameter of eth_signTypedData_v4
+type typedDataJSON struct {
+	Types       apitypes.Types            `json:"types"`
+	PrimaryType string                    `json:"primaryType"`
+	Domain      typedDataDomainJSON       `json:"domain"`
+	Message     apitypes.TypedDataMessage `json:"message"`
+}
+
+// typedDataDomainJSON encodes the chain id as a number, as wallets expect it
+type typedDataDomainJSON struct {
+	Name              string      `json:"name"`
+	Version           string      `json:"version"`
+	ChainId           json.Number `json:"chainId"`
+	VerifyingContract string      `json:"verifyingContract"`
+}
+
+// Builds the JSON typed data of the order expected by eth_signTypedData_v4,
+// with its types, domain, primaryType "Order" and message. The signature returned
+// by the wallet can be attached with AttachSignature.
+//
+// @param order
+//
+// @returns the JSON encoding of the typed data
+func (e *ExchangeOrderBuilderImpl) BuildOrderTypedDataJSON(order *model.Order, contract model.VerifyingContract) ([]byte, error) {
+	typedData, err := e.BuildOrderTypedData(order, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(typedDataJSON{
+		Types:       typedData.Types,
+		PrimaryType: typedData.PrimaryType,
+		Domain: typedDataDomainJSON{
+			Name:              typedData.Domain.Name,
+			Version:           typedData.Domain.Version,
+			ChainId:           json.Number((*big.Int)(typedData.Domain.ChainId).String()),
+			VerifyingContract: typedData.Domain.VerifyingContract,
 		},
+		Message: typedData.Message,
+	})
+}
+
+// Builds the order from order data and generates its hash without signing.
+// The hash is the same as the one embedded by BuildSignedOrder for the same salt.
+//
+// @param orderData
+//
+// @returns a OrderHash that is a 'common.Hash'
+func (e *ExchangeOrderBuilderImpl) OrderID(orderData *model.OrderData, contract model.VerifyingContract) (model.OrderHash, error) {
+	domain, domainSeparator, err := e.buildDomainSeparator(contract)
+	if err != nil {
+		return common.Hash{}, err
 	}
 
-	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	order, err := e.buildOrder(orderData, true)
 	if err != nil {
-		return common.Hash{}, fmt.Errorf("failed to hash domain: %w", err)
+		return common.Hash{}, err
 	}
 
-	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	typedData, err := buildOrderTypedData(order, domain)
 	if err != nil {
-		return common.Hash{}, fmt.Errorf("failed to hash message: %w", err)
+		return common.Hash{}, err
 	}
 
-	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-	orderHash := crypto.Keccak256Hash(rawData)
+	return hashOrder(typedData, domainSeparator)
+}
 
-	return orderHash, nil
+// Builds the order from order data and reports all the values derived from it, without signing:
+// the resolved order, the EIP712 domain and its separator, the struct hash, the order hash
+// and the payload the CLOB will see. The order built hook is not fired.
+//
+// @param orderData
+//
+// @returns the OrderExplanation of the order
+func (e *ExchangeOrderBuilderImpl) Explain(orderData *model.OrderData, contract model.VerifyingContract) (*model.OrderExplanation, error) {
+	domain, domainSeparator, err := e.buildDomainSeparator(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := e.buildOrder(orderData, true)
+	if err != nil {
+		return nil, err
+	}
+
+	typedData, err := buildOrderTypedData(order, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	structHash, err := hashOrderStruct(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	orderHash, err := hashOrder(typedData, domainSeparator)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(&model.SignedOrder{Order: *order})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.OrderExplanation{
+		Order:             order,
+		DomainName:        domain.Name,
+		DomainVersion:     domain.Version,
+		ChainID:           new(big.Int).Set(e.chainConfig.ChainID),
+		VerifyingContract: common.HexToAddress(domain.VerifyingContract),
+		DomainSeparator:   domainSeparator,
+		StructHash:        structHash,
+		OrderHash:         orderHash,
+		Payload:           payload,
+	}, nil
 }
 
+// Signs the order, the signature is normalized to its canonical low-S form.
+//
+// @param signer - the signer instance to use for signing
+//
+// @param order
+//
+// @returns the order signature
 func (e *ExchangeOrderBuilderImpl) BuildOrderSignature(s Signer, order *model.Order, contract model.VerifyingContract) (model.OrderSignature, error) {
+	domain, err := e.buildDomain(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	typedData, err := buildOrderTypedData(order, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign the typed data
+	signature, err := s.SignTypedData(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.NormalizeSignature(signature)
+}
+
+// EIP712 types of the exchange, the Order fields and their types mirror the exchange's Order struct:
+// the amounts, token id, expiration, nonce and fee rate are uint256, the side and signature type uint8 enums.
+// A different type changes the ORDER_TYPEHASH, model.OrderTypeHash, and the signatures are rejected onchain.
+var orderTypes = eip712.Types{
+	"EIP712Domain": []eip712.Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"Order": []eip712.Type{
+		{Name: "salt", Type: "uint256"},
+		{Name: "maker", Type: "address"},
+		{Name: "signer", Type: "address"},
+		{Name: "taker", Type: "address"},
+		{Name: "tokenId", Type: "uint256"},
+		{Name: "makerAmount", Type: "uint256"},
+		{Name: "takerAmount", Type: "uint256"},
+		{Name: "expiration", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "feeRateBps", Type: "uint256"},
+		{Name: "side", Type: "uint8"},
+		{Name: "signatureType", Type: "uint8"},
+	},
+}
+
+func (e *ExchangeOrderBuilderImpl) buildDomain(contract model.VerifyingContract) (eip712.TypedDataDomain, error) {
 	// Get the verifying contract address
-	verifyingContract, err := utils.GetVerifyingContractAddress(e.chainId, contract)
+	verifyingContract, err := e.chainConfig.VerifyingContractAddress(contract)
+	if err != nil {
+		return eip712.TypedDataDomain{}, err
+	}
+
+	name, version := e.chainConfig.EIP712Domain()
+
+	return eip712.TypedDataDomain{
+		Name:              name,
+		Version:           version,
+		ChainId:           e.chainConfig.ChainID.String(),
+		VerifyingContract: verifyingContract.Hex(),
+	}, nil
+}
+
+// domain of an exchange contract and its separator, cached by buildDomainSeparator
+type cachedDomain struct {
+	domain          eip712.TypedDataDomain
+	domainSeparator common.Hash
+}
+
+// The domain separator only depends on the chain and the exchange contract,
+// the chain config of a builder being immutable it is cached per contract.
+func (e *ExchangeOrderBuilderImpl) buildDomainSeparator(contract model.VerifyingContract) (eip712.TypedDataDomain, common.Hash, error) {
+	if cached, ok := e.domainSeparators.Load(contract); ok {
+		cached := cached.(cachedDomain)
+		return cached.domain, cached.domainSeparator, nil
+	}
+
+	domain, domainSeparator, err := e.computeDomainSeparator(contract)
+	if err != nil {
+		return eip712.TypedDataDomain{}, common.Hash{}, err
+	}
+
+	e.domainSeparators.Store(contract, cachedDomain{domain: domain, domainSeparator: domainSeparator})
+	return domain, domainSeparator, nil
+}
+
+func (e *ExchangeOrderBuilderImpl) computeDomainSeparator(contract model.VerifyingContract) (eip712.TypedDataDomain, common.Hash, error) {
+	domain, err := e.buildDomain(contract)
+	if err != nil {
+		return eip712.TypedDataDomain{}, common.Hash{}, err
+	}
+
+	typedData := eip712.TypedData{
+		Types:       orderTypes,
+		PrimaryType: "Order",
+		Domain:      domain,
+	}
+
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return eip712.TypedDataDomain{}, common.Hash{}, fmt.Errorf("failed to hash domain: %w", err)
+	}
+
+	return domain, common.BytesToHash(domainSeparator), nil
+}
+
+// builds and signs the order, a failure is returned as a *BuildError
+// carrying the signer and the order hash if they were known
+func (e *ExchangeOrderBuilderImpl) buildSignedOrder(ctx context.Context, s Signer, orderData *model.OrderData, domain eip712.TypedDataDomain, domainSeparator common.Hash) (*model.SignedOrder, error) {
+	buildErr := &BuildError{}
+	signedOrder, err := e.signOrderData(ctx, s, orderData, domain, domainSeparator, buildErr)
+	if err != nil {
+		buildErr.Err = err
+		return nil, buildErr
+	}
+
+	return signedOrder, nil
+}
+
+// signOrderData records the signer and the order hash in progress as soon as they are known
+func (e *ExchangeOrderBuilderImpl) signOrderData(ctx context.Context, s Signer, orderData *model.OrderData, domain eip712.TypedDataDomain, domainSeparator common.Hash, progress *BuildError) (*model.SignedOrder, error) {
+	// Aggregated signers pick the signer of each order
+	if selector, ok := s.(signer.SignerSelector); ok {
+		s = selector.Next()
+	}
+	progress.Signer = s.GetAddress()
+
+	// EOA orders are made and signed by the signing key, the exchange requires maker == signer.
+	// POLY_PROXY and POLY_GNOSIS_SAFE orders without maker are made by the wallet of the signer
+	if orderData.SignatureType == model.SignatureTypeEOA {
+		data := *orderData
+		address := s.GetAddress()
+		if data.Signer == "" {
+			data.Signer = address.Hex()
+		}
+		if data.Maker == "" {
+			data.Maker = data.Signer
+		}
+		if common.HexToAddress(data.Signer) != address {
+			return nil, fmt.Errorf("%w: signer %s of the EOA order is not the signing address %s", model.ErrInvalidSigner, data.Signer, address.Hex())
+		}
+		if common.HexToAddress(data.Maker) != address {
+			return nil, fmt.Errorf("%w: maker %s of the EOA order is not the signing address %s", model.ErrInvalidSigner, data.Maker, address.Hex())
+		}
+		orderData = &data
+	} else if orderData.Maker == "" && (orderData.SignatureType == model.SignatureTypePolyProxy ||
+		orderData.SignatureType == model.SignatureTypePolyGnosisSafe) {
+		data := *orderData
+		if data.Signer == "" {
+			data.Signer = s.GetAddress().Hex()
+		}
+		maker, err := e.chainConfig.DeriveWalletAddress(data.SignatureType, common.HexToAddress(data.Signer))
+		if err != nil {
+			return nil, err
+		}
+		data.Maker = maker.Hex()
+		orderData = &data
+	}
+
+	order, err := e.buildOrder(orderData, true)
+	if err != nil {
+		return nil, err
+	}
+	progress.Signer = order.Signer
+
+	typedData, err := buildOrderTypedData(order, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	orderHash, err := hashOrder(typedData, domainSeparator)
 	if err != nil {
 		return nil, err
 	}
+	progress.OrderHash = orderHash
+
+	signature, cached := e.cachedSignature(s, orderHash)
+	if !cached {
+		signature, err = signOrder(ctx, s, typedData, orderHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rawSignature := signature
+
+	// Validate the signature
+
+	if orderData.SignatureType == model.SignatureTypePoly1271 {
+		// EIP-1271 signatures are validated by the wallet contract, they can't be recovered locally
+		if e.contractSignaturePacker != nil {
+			signature, err = e.contractSignaturePacker(orderHash, signature)
+			if err != nil {
+				return nil, fmt.Errorf("failed to pack contract signature: %w", err)
+			}
+		}
+	} else {
+		// Emit canonical low-S signatures whatever the signer produces
+		signature, err = model.NormalizeSignature(signature)
+		if err != nil {
+			return nil, err
+		}
+
+		// A signer reporting another address than its key would produce orders the exchange rejects
+		recovered, err := recoverAddress(orderHash, signature)
+		if err != nil {
+			return nil, err
+		}
+		if recovered != order.Signer {
+			return nil, fmt.Errorf("%w: recovered %s, order signer %s", model.ErrInvalidSignature, recovered.Hex(), order.Signer.Hex())
+		}
+		if address := s.GetAddress(); recovered != address {
+			return nil, fmt.Errorf("%w: recovered %s, signing address %s", model.ErrInvalidSignature, recovered.Hex(), address.Hex())
+		}
+	}
+
+	// only signatures which passed the validation are cached
+	if e.signatureCache != nil && !cached {
+		e.signatureCache.put(s.GetAddress(), orderHash, rawSignature, e.clock.Now())
+	}
+
+	signedOrder := &model.SignedOrder{
+		Order:     *order,
+		Signature: signature,
+		OrderHash: orderHash,
+	}
+	e.orderBuilt(&signedOrder.Order, orderHash)
+
+	return signedOrder, nil
+}
+
+// cachedSignature returns the signature of the order hash by the signer if the signature cache holds it
+func (e *ExchangeOrderBuilderImpl) cachedSignature(s Signer, orderHash model.OrderHash) ([]byte, bool) {
+	if e.signatureCache == nil {
+		return nil, false
+	}
+	return e.signatureCache.get(s.GetAddress(), orderHash, e.clock.Now())
+}
+
+// signs the order digest directly when the signer supports it, saving the second EIP712 hashing
+func signOrder(ctx context.Context, s Signer, typedData eip712.TypedData, orderHash model.OrderHash) ([]byte, error) {
+	if hs, ok := s.(signer.HashContextSigner); ok {
+		return hs.SignHashContext(ctx, orderHash)
+	}
+
+	return signer.SignTypedDataContext(ctx, s, typedData)
+}
+
+// fires the order built hook, if any
+func (e *ExchangeOrderBuilderImpl) orderBuilt(order *model.Order, orderHash model.OrderHash) {
+	if e.orderBuiltHook != nil {
+		e.orderBuiltHook(order, orderHash)
+	}
+}
+
+// builds the EIP712 typed data of the order. The salt, token id and amounts are mandatory,
+// the other nil big integers are hashed as zero, e.g a nil expiration is an order without expiration.
+func buildOrderTypedData(order *model.Order, domain eip712.TypedDataDomain) (eip712.TypedData, error) {
+	for _, field := range []struct {
+		name  string
+		value *big.Int
+	}{
+		{"salt", order.Salt},
+		{"tokenId", order.TokenId},
+		{"makerAmount", order.MakerAmount},
+		{"takerAmount", order.TakerAmount},
+	} {
+		if field.value == nil {
+			return eip712.TypedData{}, fmt.Errorf("%w: %s", model.ErrMissingOrderField, field.name)
+		}
+	}
 
 	// Build the EIP712 TypedData
-	typedData := eip712.TypedData{
-		Types: eip712.Types{
-			"EIP712Domain": []eip712.Type{
-				{Name: "name", Type: "string"},
-				{Name: "version", Type: "string"},
-				{Name: "chainId", Type: "uint256"},
-				{Name: "verifyingContract", Type: "address"},
-			},
-			"Order": []eip712.Type{
-				{Name: "salt", Type: "uint256"},
-				{Name: "maker", Type: "address"},
-				{Name: "signer", Type: "address"},
-				{Name: "taker", Type: "address"},
-				{Name: "tokenId", Type: "uint256"},
-				{Name: "makerAmount", Type: "uint256"},
-				{Name: "takerAmount", Type: "uint256"},
-				{Name: "expiration", Type: "uint256"},
-				{Name: "nonce", Type: "uint256"},
-				{Name: "feeRateBps", Type: "uint256"},
-				{Name: "side", Type: "uint8"},
-				{Name: "signatureType", Type: "uint8"},
-			},
-		},
+	return eip712.TypedData{
+		Types:       orderTypes,
 		PrimaryType: "Order",
-		Domain: eip712.TypedDataDomain{
-			Name:              "Polymarket CTF Exchange",
-			Version:           "1",
-			ChainId:           e.chainId.String(),
-			VerifyingContract: verifyingContract.Hex(),
-		},
+		Domain:      domain,
 		Message: eip712.TypedDataMessage{
 			"salt":          order.Salt.String(),
 			"maker":         order.Maker.Hex(),
@@ -252,19 +1112,39 @@ func (e *ExchangeOrderBuilderImpl) BuildOrderSignature(s Signer, order *model.Or
 			"tokenId":       order.TokenId.String(),
 			"makerAmount":   order.MakerAmount.String(),
 			"takerAmount":   order.TakerAmount.String(),
-			"expiration":    order.Expiration.String(),
-			"nonce":         order.Nonce.String(),
-			"feeRateBps":    order.FeeRateBps.String(),
-			"side":          fmt.Sprintf("%d", order.Side.Uint64()),
-			"signatureType": fmt.Sprintf("%d", order.SignatureType.Uint64()),
+			"expiration":    bigIntOrZero(order.Expiration).String(),
+			"nonce":         bigIntOrZero(order.Nonce).String(),
+			"feeRateBps":    bigIntOrZero(order.FeeRateBps).String(),
+			"side":          bigIntOrZero(order.Side).String(),
+			"signatureType": bigIntOrZero(order.SignatureType).String(),
 		},
+	}, nil
+}
+
+func bigIntOrZero(x *big.Int) *big.Int {
+	if x == nil {
+		return new(big.Int)
 	}
+	return x
+}
 
-	// Sign the typed data
-	signature, err := s.SignTypedData(typedData)
+func hashOrder(typedData eip712.TypedData, domainSeparator common.Hash) (model.OrderHash, error) {
+	typedDataHash, err := hashOrderStruct(typedData)
 	if err != nil {
-		return nil, err
+		return common.Hash{}, err
+	}
+
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator[:]), string(typedDataHash[:])))
+	orderHash := crypto.Keccak256Hash(rawData)
+
+	return orderHash, nil
+}
+
+func hashOrderStruct(typedData eip712.TypedData) (common.Hash, error) {
+	structHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash message: %w", err)
 	}
 
-	return signature, nil
+	return common.BytesToHash(structHash), nil
 }
@@ -1,15 +1,24 @@
 package builder
 
 import (
+	"context"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math/big"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/ivanzzeth/ethsig"
-	polymarketcontracts "github.com/ivanzzeth/polymarket-go-contracts"
 	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/signer"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,16 +32,36 @@ var (
 	salt = int64(479249096354)
 )
 
+// goldenOrder is the order behind the golden hash and signature vectors. It is
+// built by hand since a BUY paying 100 for 50 tokens implies a price of 2,
+// which BuildOrder rejects.
+func goldenOrder() *model.Order {
+	return &model.Order{
+		Salt:          big.NewInt(salt),
+		Maker:         signerAddress,
+		Signer:        signerAddress,
+		Taker:         common.Address{},
+		TokenId:       big.NewInt(1234),
+		MakerAmount:   big.NewInt(100000000),
+		TakerAmount:   big.NewInt(50000000),
+		Expiration:    big.NewInt(0),
+		Nonce:         big.NewInt(0),
+		FeeRateBps:    big.NewInt(100),
+		Side:          model.BUY.Int(),
+		SignatureType: model.SignatureTypeEOA.Int(),
+	}
+}
+
 func TestBuildOrder(t *testing.T) {
 	// random salt
 	builder := NewExchangeOrderBuilderImpl(chainId, nil)
 
 	order, err := builder.BuildOrder(&model.OrderData{
 		Maker:       signerAddress.Hex(),
-		Taker:       "0x0",
+		Taker:       common.HexToAddress("0x0").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
@@ -45,8 +74,8 @@ func TestBuildOrder(t *testing.T) {
 	assert.Equal(t, order.Signer, signerAddress)
 	assert.Equal(t, order.Taker, common.HexToAddress("0x0"))
 	assert.Equal(t, order.TokenId.String(), "1234")
-	assert.Equal(t, order.MakerAmount.String(), "100000000")
-	assert.Equal(t, order.TakerAmount.String(), "50000000")
+	assert.Equal(t, order.MakerAmount.String(), "50000000")
+	assert.Equal(t, order.TakerAmount.String(), "100000000")
 	assert.Equal(t, order.Side.String(), "0")
 	assert.Equal(t, order.Expiration.String(), "0")
 	assert.Equal(t, order.Nonce.String(), "0")
@@ -58,10 +87,10 @@ func TestBuildOrder(t *testing.T) {
 
 	order, err = builder.BuildOrder(&model.OrderData{
 		Maker:       signerAddress.Hex(),
-		Taker:       "0x1",
+		Taker:       common.HexToAddress("0x1").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
@@ -74,8 +103,8 @@ func TestBuildOrder(t *testing.T) {
 	assert.Equal(t, order.Signer, signerAddress)
 	assert.Equal(t, order.Taker, common.HexToAddress("0x1"))
 	assert.Equal(t, order.TokenId.String(), "1234")
-	assert.Equal(t, order.MakerAmount.String(), "100000000")
-	assert.Equal(t, order.TakerAmount.String(), "50000000")
+	assert.Equal(t, order.MakerAmount.String(), "50000000")
+	assert.Equal(t, order.TakerAmount.String(), "100000000")
 	assert.Equal(t, order.Side.String(), "0")
 	assert.Equal(t, order.Expiration.String(), "0")
 	assert.Equal(t, order.Nonce.String(), "0")
@@ -83,37 +112,243 @@ func TestBuildOrder(t *testing.T) {
 	assert.Equal(t, order.SignatureType.String(), "0")
 }
 
-func TestBuildOrderHash(t *testing.T) {
-	// FEE
-	// random salt
-	builder := NewExchangeOrderBuilderImpl(chainId, nil)
+func TestBuildMarketOrder(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
 
-	order, err := builder.BuildOrder(&model.OrderData{
+	// buy: spend 100 USDC at 0.5
+	order, err := builder.BuildMarketOrder(&model.MarketOrderData{
+		Maker:      signerAddress.Hex(),
+		Taker:      common.HexToAddress("0x0").Hex(),
+		TokenId:    "1234",
+		Amount:     "100",
+		Price:      "0.5",
+		Side:       model.BUY,
+		FeeRateBps: "100",
+		Nonce:      "0",
+	}, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.NotNil(t, order)
+
+	assert.Equal(t, order.Salt.Int64(), salt)
+	assert.Equal(t, order.Maker, signerAddress)
+	assert.Equal(t, order.Signer, signerAddress)
+	assert.Equal(t, order.TokenId.String(), "1234")
+	assert.Equal(t, order.MakerAmount.String(), "100000000")
+	assert.Equal(t, order.TakerAmount.String(), "200000000")
+	assert.Equal(t, order.Side.String(), "0")
+	assert.Equal(t, order.Expiration.String(), "0")
+
+	// sell: sell 100 shares at 0.5
+	order, err = builder.BuildMarketOrder(&model.MarketOrderData{
+		Maker:      signerAddress.Hex(),
+		Taker:      common.HexToAddress("0x0").Hex(),
+		TokenId:    "1234",
+		Amount:     "100",
+		Price:      "0.5",
+		Side:       model.SELL,
+		FeeRateBps: "100",
+		Nonce:      "0",
+	}, model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+	assert.NotNil(t, order)
+
+	assert.Equal(t, order.MakerAmount.String(), "100000000")
+	assert.Equal(t, order.TakerAmount.String(), "50000000")
+	assert.Equal(t, order.Side.String(), "1")
+
+	// zero amount
+	_, err = builder.BuildMarketOrder(&model.MarketOrderData{
+		Maker:      signerAddress.Hex(),
+		Taker:      common.HexToAddress("0x0").Hex(),
+		TokenId:    "1234",
+		Amount:     "0",
+		Price:      "0.5",
+		Side:       model.BUY,
+		FeeRateBps: "100",
+		Nonce:      "0",
+	}, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrZeroAmount)
+
+	// negative amount
+	_, err = builder.BuildMarketOrder(&model.MarketOrderData{
+		Maker:      signerAddress.Hex(),
+		Taker:      common.HexToAddress("0x0").Hex(),
+		TokenId:    "1234",
+		Amount:     "-5",
+		Price:      "0.5",
+		Side:       model.SELL,
+		FeeRateBps: "100",
+		Nonce:      "0",
+	}, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrZeroAmount)
+}
+
+func TestBuildOrderFromPrice(t *testing.T) {
+	for _, tc := range []struct {
+		tickSize      string
+		side          model.Side
+		price         string
+		size          string
+		expectedPrice string
+	}{
+		{"0.01", model.BUY, "0.5", "100", "0.5"},
+		{"0.01", model.SELL, "0.5", "100", "0.5"},
+		// the price is rounded to the nearest tick
+		{"0.01", model.BUY, "0.523", "100", "0.52"},
+		{"0.01", model.SELL, "0.527", "100", "0.53"},
+		{"0.001", model.BUY, "0.0137", "33.33", "0.014"},
+		{"0.001", model.SELL, "0.9991", "12.345", "0.999"},
+		{"0.0001", model.BUY, "0.12345", "7", "0.1235"},
+	} {
+		tickSize := decimal.RequireFromString(tc.tickSize)
+		builder := NewExchangeOrderBuilderImpl(chainId, nil, WithTickSize(tickSize))
+
+		signedOrder, err := builder.BuildOrderFromPrice(signer.NewPrivateKeySigner(privateKey), model.PriceOrderParams{
+			Maker:      signerAddress.Hex(),
+			TokenId:    "1234",
+			Price:      tc.price,
+			Size:       tc.size,
+			Side:       tc.side,
+			FeeRateBps: "0",
+			Nonce:      "0",
+		}, model.CTFExchange)
+		if !assert.NoError(t, err, tc.price) {
+			continue
+		}
+
+		// the implied price is the requested price, within the tick size
+		price := model.ImpliedPrice(tc.side, signedOrder.MakerAmount, signedOrder.TakerAmount)
+		assert.True(t, decimal.RequireFromString(tc.expectedPrice).Equal(price), "%s: implied price %s", tc.price, price)
+		assert.True(t, price.Sub(decimal.RequireFromString(tc.price)).Abs().LessThanOrEqual(tickSize.Div(decimal.NewFromInt(2))))
+		assert.True(t, model.IsPriceOnTick(price, tickSize))
+
+		// the order reads back the price and the size, rounded to the CLOB size decimals
+		assert.True(t, price.Equal(signedOrder.Price()), "%s: price %s", tc.price, signedOrder.Price())
+		expectedSize := decimal.RequireFromString(tc.size).RoundFloor(model.OrderSizeDecimals)
+		assert.True(t, expectedSize.Equal(signedOrder.Size()), "%s: size %s", tc.size, signedOrder.Size())
+
+		valid, err := builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	builder := NewExchangeOrderBuilderImpl(chainId, nil, WithTickSize(decimal.RequireFromString("0.01")))
+	params := model.PriceOrderParams{
+		Maker:      signerAddress.Hex(),
+		TokenId:    "1234",
+		Price:      "0.5",
+		Size:       "100",
+		Side:       model.BUY,
+		FeeRateBps: "0",
+		Nonce:      "0",
+	}
+
+	// a price rounded to zero
+	params.Price = "0.004"
+	_, err := builder.BuildOrderFromPrice(signer.NewPrivateKeySigner(privateKey), params, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrPriceOutOfRange)
+
+	// a price of 1 is out of range
+	params.Price = "1"
+	_, err = builder.BuildOrderFromPrice(signer.NewPrivateKeySigner(privateKey), params, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrPriceOutOfRange)
+
+	params.Price = "0.5"
+	params.Size = "0.001"
+	_, err = builder.BuildOrderFromPrice(signer.NewPrivateKeySigner(privateKey), params, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrZeroAmount)
+
+	params.Size = "abc"
+	_, err = builder.BuildOrderFromPrice(signer.NewPrivateKeySigner(privateKey), params, model.CTFExchange)
+	assert.Error(t, err)
+}
+
+func TestDomainSeparator(t *testing.T) {
+	// polygon mainnet
+	builder := NewExchangeOrderBuilderImpl(big.NewInt(137), nil)
+
+	domainSeparator, err := builder.DomainSeparator(model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1a573e3617c78403b5b4b892827992f027b03d4eaf570048b8ee8cdd84d151be", domainSeparator.Hex())
+
+	domainSeparator, err = builder.DomainSeparator(model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x82cb6aa85babb812f4b521a12b10f0cbc68d2b44be7bc02c047004f544adb49f", domainSeparator.Hex())
+
+	// wrong contract
+	_, err = builder.DomainSeparator(model.VerifyingContract(100))
+	assert.Error(t, err)
+
+	// wrong network
+	builder = NewExchangeOrderBuilderImpl(big.NewInt(1), nil)
+
+	_, err = builder.DomainSeparator(model.CTFExchange)
+	assert.Error(t, err)
+}
+
+func TestDomainSeparatorFork(t *testing.T) {
+	order, err := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt }).BuildOrder(&model.OrderData{
 		Maker:       signerAddress.Hex(),
 		Taker:       common.HexToAddress("0x0").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
 	})
 	assert.NoError(t, err)
-	assert.NotNil(t, order)
 
-	orderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	polymarket := NewExchangeOrderBuilderImpl(chainId, nil)
+	polymarketSeparator, err := polymarket.DomainSeparator(model.CTFExchange)
+	assert.NoError(t, err)
+	polymarketHash, err := polymarket.BuildOrderHash(order, model.CTFExchange)
 	assert.NoError(t, err)
-	assert.NotNil(t, orderHash)
 
-	// specific salt
-	builder = NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	// the default values are Polymarket's
+	config := model.AmoyChainConfig()
+	config.DomainName = model.DefaultDomainName
+	config.DomainVersion = model.DefaultDomainVersion
+	domainSeparator, err := NewExchangeOrderBuilder(WithChainConfig(config)).DomainSeparator(model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, polymarketSeparator, domainSeparator)
 
-	order, err = builder.BuildOrder(&model.OrderData{
+	for _, domain := range [][2]string{
+		{"Fork CTF Exchange", model.DefaultDomainVersion},
+		{model.DefaultDomainName, "2"},
+	} {
+		config := model.AmoyChainConfig()
+		config.DomainName, config.DomainVersion = domain[0], domain[1]
+		fork := NewExchangeOrderBuilder(WithChainConfig(config))
+
+		domainSeparator, err := fork.DomainSeparator(model.CTFExchange)
+		assert.NoError(t, err)
+		assert.NotEqual(t, polymarketSeparator, domainSeparator)
+
+		orderHash, err := fork.BuildOrderHash(order, model.CTFExchange)
+		assert.NoError(t, err)
+		assert.NotEqual(t, polymarketHash, orderHash)
+
+		// the struct hash doesn't depend on the domain
+		structHash, err := fork.OrderStructHash(order)
+		assert.NoError(t, err)
+		polymarketStructHash, err := polymarket.OrderStructHash(order)
+		assert.NoError(t, err)
+		assert.Equal(t, polymarketStructHash, structHash)
+	}
+}
+
+func TestBuildOrderHash(t *testing.T) {
+	// FEE
+	// random salt
+	builder := NewExchangeOrderBuilderImpl(chainId, nil)
+
+	order, err := builder.BuildOrder(&model.OrderData{
 		Maker:       signerAddress.Hex(),
 		Taker:       common.HexToAddress("0x0").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
@@ -121,7 +356,14 @@ func TestBuildOrderHash(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, order)
 
-	orderHash, err = builder.BuildOrderHash(order, model.CTFExchange)
+	orderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.NotNil(t, orderHash)
+
+	// specific salt
+	builder = NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	orderHash, err = builder.BuildOrderHash(goldenOrder(), model.CTFExchange)
 	assert.NoError(t, err)
 	assert.NotNil(t, orderHash)
 
@@ -136,8 +378,8 @@ func TestBuildOrderHash(t *testing.T) {
 		Maker:       signerAddress.Hex(),
 		Taker:       common.HexToAddress("0x0").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
@@ -152,20 +394,7 @@ func TestBuildOrderHash(t *testing.T) {
 	// specific salt
 	builder = NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
 
-	order, err = builder.BuildOrder(&model.OrderData{
-		Maker:       signerAddress.Hex(),
-		Taker:       common.HexToAddress("0x0").Hex(),
-		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
-		Side:        model.BUY,
-		FeeRateBps:  "100",
-		Nonce:       "0",
-	})
-	assert.NoError(t, err)
-	assert.NotNil(t, order)
-
-	orderHash, err = builder.BuildOrderHash(order, model.NegRiskCTFExchange)
+	orderHash, err = builder.BuildOrderHash(goldenOrder(), model.NegRiskCTFExchange)
 	assert.NoError(t, err)
 	assert.NotNil(t, orderHash)
 
@@ -183,8 +412,8 @@ func TestBuildOrderSignature(t *testing.T) {
 		Maker:       signerAddress.Hex(),
 		Taker:       common.HexToAddress("0x0").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
@@ -203,24 +432,11 @@ func TestBuildOrderSignature(t *testing.T) {
 	// specific salt
 	builder = NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
 
-	order, err = builder.BuildOrder(&model.OrderData{
-		Maker:       signerAddress.Hex(),
-		Taker:       common.HexToAddress("0x0").Hex(),
-		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
-		Side:        model.BUY,
-		FeeRateBps:  "100",
-		Nonce:       "0",
-	})
-	assert.NoError(t, err)
-	assert.NotNil(t, order)
-
-	orderHash, err = builder.BuildOrderHash(order, model.CTFExchange)
+	orderHash, err = builder.BuildOrderHash(goldenOrder(), model.CTFExchange)
 	assert.NoError(t, err)
 	assert.NotNil(t, orderHash)
 
-	orderSignature, err = builder.BuildOrderSignature(ethSigner, order, model.CTFExchange)
+	orderSignature, err = builder.BuildOrderSignature(ethSigner, goldenOrder(), model.CTFExchange)
 	assert.NoError(t, err)
 	assert.NotNil(t, orderSignature)
 
@@ -235,8 +451,8 @@ func TestBuildOrderSignature(t *testing.T) {
 		Maker:       signerAddress.Hex(),
 		Taker:       common.HexToAddress("0x0").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
@@ -255,24 +471,11 @@ func TestBuildOrderSignature(t *testing.T) {
 	// specific salt
 	builder = NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
 
-	order, err = builder.BuildOrder(&model.OrderData{
-		Maker:       signerAddress.Hex(),
-		Taker:       common.HexToAddress("0x0").Hex(),
-		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
-		Side:        model.BUY,
-		FeeRateBps:  "100",
-		Nonce:       "0",
-	})
-	assert.NoError(t, err)
-	assert.NotNil(t, order)
-
-	orderHash, err = builder.BuildOrderHash(order, model.NegRiskCTFExchange)
+	orderHash, err = builder.BuildOrderHash(goldenOrder(), model.NegRiskCTFExchange)
 	assert.NoError(t, err)
 	assert.NotNil(t, orderHash)
 
-	orderSignature, err = builder.BuildOrderSignature(ethSigner, order, model.NegRiskCTFExchange)
+	orderSignature, err = builder.BuildOrderSignature(ethSigner, goldenOrder(), model.NegRiskCTFExchange)
 	assert.NoError(t, err)
 	assert.NotNil(t, orderSignature)
 
@@ -290,8 +493,8 @@ func TestBuildSignedOrder(t *testing.T) {
 		Maker:       signerAddress.Hex(),
 		Taker:       common.HexToAddress("0x0").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
@@ -303,8 +506,8 @@ func TestBuildSignedOrder(t *testing.T) {
 	assert.Equal(t, signedOrder.Maker, signerAddress)
 	assert.Equal(t, signedOrder.Signer, signerAddress)
 	assert.Equal(t, signedOrder.TokenId.String(), "1234")
-	assert.Equal(t, signedOrder.MakerAmount.String(), "100000000")
-	assert.Equal(t, signedOrder.TakerAmount.String(), "50000000")
+	assert.Equal(t, signedOrder.MakerAmount.String(), "50000000")
+	assert.Equal(t, signedOrder.TakerAmount.String(), "100000000")
 	assert.Equal(t, signedOrder.Side.String(), "0")
 	assert.Equal(t, signedOrder.Expiration.String(), "0")
 	assert.Equal(t, signedOrder.Nonce.String(), "0")
@@ -320,8 +523,8 @@ func TestBuildSignedOrder(t *testing.T) {
 		Maker:       signerAddress.Hex(),
 		Taker:       common.HexToAddress("0x0").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
@@ -333,8 +536,8 @@ func TestBuildSignedOrder(t *testing.T) {
 	assert.Equal(t, signedOrder.Maker, signerAddress)
 	assert.Equal(t, signedOrder.Signer, signerAddress)
 	assert.Equal(t, signedOrder.TokenId.String(), "1234")
-	assert.Equal(t, signedOrder.MakerAmount.String(), "100000000")
-	assert.Equal(t, signedOrder.TakerAmount.String(), "50000000")
+	assert.Equal(t, signedOrder.MakerAmount.String(), "50000000")
+	assert.Equal(t, signedOrder.TakerAmount.String(), "100000000")
 	assert.Equal(t, signedOrder.Side.String(), "0")
 	assert.Equal(t, signedOrder.Expiration.String(), "0")
 	assert.Equal(t, signedOrder.Nonce.String(), "0")
@@ -342,7 +545,7 @@ func TestBuildSignedOrder(t *testing.T) {
 	assert.Equal(t, signedOrder.SignatureType.String(), "0")
 	assert.NotEmpty(t, hex.EncodeToString(signedOrder.Signature))
 
-	expectedSignature := "302cd9abd0b5fcaa202a344437ec0b6660da984e24ae9ad915a592a90facf5a51bb8a873cd8d270f070217fea1986531d5eec66f1162a81f66e026db653bf7ce1c"
+	expectedSignature := "13e2d3ba622dc5ab9813e47dd9c66691437a2e3262af596e8fc8b46ab105bd375f9d3626b9bee506d48990e1418f3434251d39e22269795b16d1a126ae6f19dd1c"
 	assert.Equal(t, expectedSignature, common.Bytes2Hex(signedOrder.Signature))
 
 	// NegRisk
@@ -353,8 +556,8 @@ func TestBuildSignedOrder(t *testing.T) {
 		Maker:       signerAddress.Hex(),
 		Taker:       common.HexToAddress("0x0").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
@@ -366,8 +569,8 @@ func TestBuildSignedOrder(t *testing.T) {
 	assert.Equal(t, signedOrder.Maker, signerAddress)
 	assert.Equal(t, signedOrder.Signer, signerAddress)
 	assert.Equal(t, signedOrder.TokenId.String(), "1234")
-	assert.Equal(t, signedOrder.MakerAmount.String(), "100000000")
-	assert.Equal(t, signedOrder.TakerAmount.String(), "50000000")
+	assert.Equal(t, signedOrder.MakerAmount.String(), "50000000")
+	assert.Equal(t, signedOrder.TakerAmount.String(), "100000000")
 	assert.Equal(t, signedOrder.Side.String(), "0")
 	assert.Equal(t, signedOrder.Expiration.String(), "0")
 	assert.Equal(t, signedOrder.Nonce.String(), "0")
@@ -383,8 +586,8 @@ func TestBuildSignedOrder(t *testing.T) {
 		Maker:       signerAddress.Hex(),
 		Taker:       common.HexToAddress("0x0").Hex(),
 		TokenId:     "1234",
-		MakerAmount: "100000000",
-		TakerAmount: "50000000",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
 		Side:        model.BUY,
 		FeeRateBps:  "100",
 		Nonce:       "0",
@@ -396,8 +599,8 @@ func TestBuildSignedOrder(t *testing.T) {
 	assert.Equal(t, signedOrder.Maker, signerAddress)
 	assert.Equal(t, signedOrder.Signer, signerAddress)
 	assert.Equal(t, signedOrder.TokenId.String(), "1234")
-	assert.Equal(t, signedOrder.MakerAmount.String(), "100000000")
-	assert.Equal(t, signedOrder.TakerAmount.String(), "50000000")
+	assert.Equal(t, signedOrder.MakerAmount.String(), "50000000")
+	assert.Equal(t, signedOrder.TakerAmount.String(), "100000000")
 	assert.Equal(t, signedOrder.Side.String(), "0")
 	assert.Equal(t, signedOrder.Expiration.String(), "0")
 	assert.Equal(t, signedOrder.Nonce.String(), "0")
@@ -405,7 +608,7 @@ func TestBuildSignedOrder(t *testing.T) {
 	assert.Equal(t, signedOrder.SignatureType.String(), "0")
 	assert.NotEmpty(t, hex.EncodeToString(signedOrder.Signature))
 
-	expectedSignature = "1b3646ef347e5bd144c65bd3357ba19c12c12abaeedae733cf8579bc51a2752c0454c3bc6b236957e393637982c769b8dc0706c0f5c399983d933850afd1cbcd1c"
+	expectedSignature = "a45f0bb5956f3a26a5cc6a5b0e98f52294dd448ce86d00306be2baa0dcaaefbe7738bbed023edab5c135a73a1b7ecfdf4281d72a0833df08b2dc074e33825fbb1b"
 	assert.Equal(t, expectedSignature, common.Bytes2Hex(signedOrder.Signature))
 }
 
@@ -424,9 +627,2223 @@ func TestBuildSignedOrder2(t *testing.T) {
 		FeeRateBps:    "100",
 		Nonce:         "0",
 		Expiration:    "0",
-		SignatureType: polymarketcontracts.SignatureTypePolyGnosisSafe,
+		SignatureType: model.SignatureTypePolyGnosisSafe,
 	}, model.NegRiskCTFExchange)
 	assert.NoError(t, err)
 	assert.NotNil(t, signedOrder)
 
 }
+
+func TestBuildSignedOrderSignatureTypes(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, nil)
+	ethSigner := ethsig.NewEthPrivateKeySigner(privateKey)
+	proxyAddress := common.HexToAddress("0xaFB8270A801862270FebB3763505b136491e557b")
+
+	cases := []struct {
+		signatureType model.SignatureType
+		maker         common.Address
+	}{
+		{model.SignatureTypeEOA, signerAddress},
+		{model.SignatureTypePolyProxy, proxyAddress},
+		{model.SignatureTypePolyGnosisSafe, proxyAddress},
+	}
+
+	for _, c := range cases {
+		signedOrder, err := builder.BuildSignedOrder(ethSigner, &model.OrderData{
+			Maker:         c.maker.Hex(),
+			Signer:        signerAddress.Hex(),
+			Taker:         common.HexToAddress("0x0").Hex(),
+			TokenId:       "1234",
+			MakerAmount:   "50000000",
+			TakerAmount:   "100000000",
+			Side:          model.BUY,
+			FeeRateBps:    "100",
+			Nonce:         "0",
+			SignatureType: c.signatureType,
+		}, model.CTFExchange)
+		assert.NoError(t, err)
+		assert.NotNil(t, signedOrder)
+
+		assert.Equal(t, c.maker, signedOrder.Maker)
+		assert.Equal(t, signerAddress, signedOrder.Signer)
+		assert.Equal(t, int64(c.signatureType), signedOrder.SignatureType.Int64())
+
+		// the EOA key signs the order, whatever wallet holds the funds
+		signature := common.CopyBytes(signedOrder.Signature)
+		signature[64] -= 27
+		publicKey, err := crypto.SigToPub(signedOrder.OrderHash[:], signature)
+		assert.NoError(t, err)
+		assert.Equal(t, signerAddress, crypto.PubkeyToAddress(*publicKey))
+	}
+
+	// maker must be the proxy wallet, not the signer
+	for _, signatureType := range []model.SignatureType{model.SignatureTypePolyProxy, model.SignatureTypePolyGnosisSafe} {
+		_, err := builder.BuildSignedOrder(ethSigner, &model.OrderData{
+			Maker:         signerAddress.Hex(),
+			Signer:        signerAddress.Hex(),
+			Taker:         common.HexToAddress("0x0").Hex(),
+			TokenId:       "1234",
+			MakerAmount:   "50000000",
+			TakerAmount:   "100000000",
+			Side:          model.BUY,
+			FeeRateBps:    "100",
+			Nonce:         "0",
+			SignatureType: signatureType,
+		}, model.CTFExchange)
+		assert.ErrorIs(t, err, model.ErrInvalidSigner)
+	}
+}
+
+func TestBuildSignedOrders(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	ethSigner := ethsig.NewEthPrivateKeySigner(privateKey)
+
+	orders := make([]*model.OrderData, 20)
+	for i := range orders {
+		orders[i] = &model.OrderData{
+			Maker:       signerAddress.Hex(),
+			Taker:       common.HexToAddress("0x0").Hex(),
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       big.NewInt(int64(i)).String(),
+		}
+	}
+
+	signedOrders, err := builder.BuildSignedOrders(ethSigner, orders, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Len(t, signedOrders, len(orders))
+
+	for i, signedOrder := range signedOrders {
+		expected, err := builder.BuildSignedOrder(ethSigner, orders[i], model.CTFExchange)
+		assert.NoError(t, err)
+
+		assert.Equal(t, expected.OrderHash, signedOrder.OrderHash)
+		assert.Equal(t, expected.Signature, signedOrder.Signature)
+		assert.Equal(t, orders[i].Nonce, signedOrder.Nonce.String())
+	}
+
+	// partial result
+	orders[7].TokenId = "invalid"
+
+	signedOrders, err = builder.BuildSignedOrders(ethSigner, orders, model.CTFExchange)
+	assert.ErrorContains(t, err, "order 7")
+	assert.Len(t, signedOrders, len(orders))
+
+	for i, signedOrder := range signedOrders {
+		if i == 7 {
+			assert.Nil(t, signedOrder)
+		} else {
+			assert.NotNil(t, signedOrder)
+		}
+	}
+
+	// wrong contract
+	_, err = builder.BuildSignedOrders(ethSigner, orders, model.VerifyingContract(100))
+	assert.Error(t, err)
+}
+
+func TestBuildSignedOrdersMulti(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	ethSigner := ethsig.NewEthPrivateKeySigner(privateKey)
+
+	items := make([]OrderBatchItem, 20)
+	for i := range items {
+		contract := model.CTFExchange
+		if i%3 == 0 {
+			contract = model.NegRiskCTFExchange
+		}
+		items[i] = OrderBatchItem{
+			Data: &model.OrderData{
+				Maker:       signerAddress.Hex(),
+				TokenId:     "1234",
+				MakerAmount: "50000000",
+				TakerAmount: "100000000",
+				Side:        model.BUY,
+				FeeRateBps:  "100",
+				Nonce:       big.NewInt(int64(i)).String(),
+			},
+			Contract: contract,
+		}
+	}
+
+	signedOrders, err := builder.BuildSignedOrdersMulti(ethSigner, items)
+	assert.NoError(t, err)
+	assert.Len(t, signedOrders, len(items))
+
+	for i, signedOrder := range signedOrders {
+		expected, err := builder.BuildSignedOrder(ethSigner, items[i].Data, items[i].Contract)
+		assert.NoError(t, err)
+
+		assert.Equal(t, expected.OrderHash, signedOrder.OrderHash, "order %d", i)
+		assert.Equal(t, expected.Signature, signedOrder.Signature)
+
+		// the same order data signed for the other exchange has another hash
+		other := model.CTFExchange
+		if items[i].Contract == model.CTFExchange {
+			other = model.NegRiskCTFExchange
+		}
+		otherHash, err := builder.OrderID(items[i].Data, other)
+		assert.NoError(t, err)
+		assert.NotEqual(t, otherHash, signedOrder.OrderHash)
+	}
+
+	// partial result
+	items[4].Data.TokenId = "invalid"
+	signedOrders, err = builder.BuildSignedOrdersMulti(ethSigner, items)
+	var buildErr *BuildError
+	assert.ErrorAs(t, err, &buildErr)
+	assert.Equal(t, 4, buildErr.Index)
+	assert.Nil(t, signedOrders[4])
+	assert.NotNil(t, signedOrders[3])
+
+	// wrong contract
+	items[4].Data.TokenId = "1234"
+	items[5].Contract = model.VerifyingContract(100)
+	_, err = builder.BuildSignedOrdersMulti(ethSigner, items)
+	assert.ErrorIs(t, err, model.ErrInvalidContract)
+	assert.ErrorContains(t, err, "order 5")
+}
+
+func TestBuildSignedOrdersBuildError(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	ethSigner := signer.NewPrivateKeySigner(privateKey)
+
+	orders := make([]*model.OrderData, 4)
+	for i := range orders {
+		orders[i] = &model.OrderData{
+			Maker:       signerAddress.Hex(),
+			Taker:       common.HexToAddress("0x0").Hex(),
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       big.NewInt(int64(i)).String(),
+		}
+	}
+	orders[1].TokenId = "invalid"
+	orders[3].TokenId = "invalid"
+
+	_, err := builder.BuildSignedOrders(ethSigner, orders, model.CTFExchange)
+	var buildErr *BuildError
+	assert.True(t, errors.As(err, &buildErr))
+	assert.Equal(t, 1, buildErr.Index)
+	assert.Equal(t, signerAddress, buildErr.Signer)
+	// failed before hashing
+	assert.Equal(t, model.OrderHash{}, buildErr.OrderHash)
+	assert.ErrorContains(t, err, "order 1")
+	assert.ErrorContains(t, err, "order 3")
+
+	// a signing failure carries the order hash
+	signErr := errors.New("kms unavailable")
+	failingSigner := signer.NewFuncSigner(signerAddress, func(hash []byte) ([]byte, error) {
+		return nil, signErr
+	})
+	orders = orders[:1]
+
+	_, err = builder.BuildSignedOrders(failingSigner, orders, model.CTFExchange)
+	assert.ErrorIs(t, err, signErr)
+	assert.True(t, errors.As(err, &buildErr))
+	assert.Equal(t, 0, buildErr.Index)
+	assert.Equal(t, signerAddress, buildErr.Signer)
+	expectedHash, err := builder.OrderID(orders[0], model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHash, buildErr.OrderHash)
+
+	// a single order returns the failure as is
+	_, err = builder.BuildSignedOrder(failingSigner, orders[0], model.CTFExchange)
+	assert.Equal(t, signErr, err)
+}
+
+func TestBuildSignedOrderEOASigner(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	ethSigner := signer.NewPrivateKeySigner(privateKey)
+	otherAddress := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+
+	orderData := func(maker, signer string) *model.OrderData {
+		return &model.OrderData{
+			Maker:       maker,
+			Signer:      signer,
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       "0",
+		}
+	}
+
+	// unset, matching and matching in another case all produce the same order
+	expected, err := builder.BuildSignedOrder(ethSigner, orderData(signerAddress.Hex(), signerAddress.Hex()), model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, signerAddress, expected.Maker)
+	assert.Equal(t, signerAddress, expected.Signer)
+
+	for _, data := range []*model.OrderData{
+		orderData("", ""),
+		orderData(signerAddress.Hex(), ""),
+		orderData("", signerAddress.Hex()),
+		orderData(strings.ToLower(signerAddress.Hex()), signerAddress.Hex()),
+	} {
+		signedOrder, err := builder.BuildSignedOrder(ethSigner, data, model.CTFExchange)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, signedOrder)
+	}
+
+	// mismatching
+	for _, data := range []*model.OrderData{
+		orderData(otherAddress.Hex(), ""),
+		orderData("", otherAddress.Hex()),
+		orderData(otherAddress.Hex(), otherAddress.Hex()),
+		orderData(signerAddress.Hex(), otherAddress.Hex()),
+		orderData(otherAddress.Hex(), signerAddress.Hex()),
+	} {
+		_, err := builder.BuildSignedOrder(ethSigner, data, model.CTFExchange)
+		assert.ErrorIs(t, err, model.ErrInvalidSigner)
+	}
+}
+
+func TestBuildSignedOrderSignerAddress(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	otherAddress := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	sign := func(hash []byte) ([]byte, error) { return crypto.Sign(hash, privateKey) }
+
+	orderData := func(signatureType model.SignatureType, maker, signer string) *model.OrderData {
+		return &model.OrderData{
+			Maker:         maker,
+			Signer:        signer,
+			TokenId:       "1234",
+			MakerAmount:   "50000000",
+			TakerAmount:   "100000000",
+			Side:          model.BUY,
+			FeeRateBps:    "100",
+			Nonce:         "0",
+			SignatureType: signatureType,
+		}
+	}
+
+	// the maker and the signer default to the address of the signer
+	signedOrder, err := builder.BuildSignedOrder(signer.NewFuncSigner(signerAddress, sign), orderData(model.SignatureTypeEOA, "", ""), model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, signerAddress, signedOrder.Maker)
+	assert.Equal(t, signerAddress, signedOrder.Signer)
+	assert.Equal(t, "0x5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b", signedOrder.OrderHash.Hex())
+
+	// a signer whose key doesn't match its address
+	_, err = builder.BuildSignedOrder(signer.NewFuncSigner(otherAddress, sign), orderData(model.SignatureTypeEOA, "", ""), model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrInvalidSignature)
+	assert.ErrorContains(t, err, "recovered "+signerAddress.Hex())
+
+	// even when the order names the address of the key
+	proxyWallet := "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC"
+	_, err = builder.BuildSignedOrder(signer.NewFuncSigner(otherAddress, sign), orderData(model.SignatureTypePolyProxy, proxyWallet, signerAddress.Hex()), model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrInvalidSignature)
+	assert.ErrorContains(t, err, "signing address "+otherAddress.Hex())
+}
+
+func TestVerifySignedOrder(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, nil)
+	ethSigner := ethsig.NewEthPrivateKeySigner(privateKey)
+
+	// EOA
+	signedOrder, err := builder.BuildSignedOrder(ethSigner, &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}, model.CTFExchange)
+	assert.NoError(t, err)
+
+	ok, err := builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// wrong contract
+	ok, err = builder.VerifySignedOrder(signedOrder, model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// wrong chain
+	ok, err = NewExchangeOrderBuilderImpl(big.NewInt(137), nil).VerifySignedOrder(signedOrder, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// POLY_GNOSIS_SAFE recovers to the signer, not the maker
+	signedOrder, err = builder.BuildSignedOrder(ethSigner, &model.OrderData{
+		Maker:         "0xaFB8270A801862270FebB3763505b136491e557b",
+		Signer:        signerAddress.Hex(),
+		Taker:         common.HexToAddress("0x0").Hex(),
+		TokenId:       "1234",
+		MakerAmount:   "50000000",
+		TakerAmount:   "100000000",
+		Side:          model.BUY,
+		FeeRateBps:    "100",
+		Nonce:         "0",
+		SignatureType: model.SignatureTypePolyGnosisSafe,
+	}, model.CTFExchange)
+	assert.NoError(t, err)
+
+	ok, err = builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// tampered order
+	tampered := *signedOrder
+	tampered.MakerAmount = big.NewInt(1)
+	ok, err = builder.VerifySignedOrder(&tampered, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// wrong signature length
+	tampered = *signedOrder
+	tampered.Signature = signedOrder.Signature[:64]
+	_, err = builder.VerifySignedOrder(&tampered, model.CTFExchange)
+	assert.ErrorIs(t, err, ErrInvalidSignatureLen)
+
+	// v out of range
+	tampered = *signedOrder
+	tampered.Signature = common.CopyBytes(signedOrder.Signature)
+	tampered.Signature[64] = 30
+	_, err = builder.VerifySignedOrder(&tampered, model.CTFExchange)
+	assert.ErrorIs(t, err, ErrInvalidSignatureV)
+}
+
+func TestVerifyOrderMatches(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, nil)
+
+	signedOrder, err := builder.BuildSignedOrder(signer.NewPrivateKeySigner(privateKey), &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}, model.CTFExchange)
+	assert.NoError(t, err)
+
+	maker := signerAddress
+	side := model.BUY
+	expected := model.OrderExpectation{Maker: &maker, TokenId: big.NewInt(1234), Side: &side}
+	assert.NoError(t, builder.VerifyOrderMatches(signedOrder, expected, model.CTFExchange))
+
+	// nil fields are skipped
+	assert.NoError(t, builder.VerifyOrderMatches(signedOrder, model.OrderExpectation{}, model.CTFExchange))
+
+	// signed for another outcome
+	err = builder.VerifyOrderMatches(signedOrder, model.OrderExpectation{Maker: &maker, TokenId: big.NewInt(5678)}, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrOrderMismatch)
+	var mismatch *model.OrderMismatchError
+	assert.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, "tokenId", mismatch.Field)
+
+	// tampered after signing
+	tampered := *signedOrder
+	tampered.TokenId = big.NewInt(5678)
+	err = builder.VerifyOrderMatches(&tampered, model.OrderExpectation{TokenId: big.NewInt(5678)}, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrInvalidSignature)
+
+	// malformed signature
+	tampered = *signedOrder
+	tampered.Signature = signedOrder.Signature[:64]
+	err = builder.VerifyOrderMatches(&tampered, expected, model.CTFExchange)
+	assert.ErrorIs(t, err, ErrInvalidSignatureLen)
+}
+
+func TestRecoverOrderSigner(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	ethSigner := ethsig.NewEthPrivateKeySigner(privateKey)
+
+	order, err := builder.BuildOrder(&model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	})
+	assert.NoError(t, err)
+
+	orderSignature, err := builder.BuildOrderSignature(ethSigner, order, model.CTFExchange)
+	assert.NoError(t, err)
+
+	// v is 27/28
+	recovered, err := builder.RecoverOrderSigner(order, orderSignature, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, signerAddress, recovered)
+
+	// v is 0/1
+	orderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+
+	rawSignature, err := crypto.Sign(orderHash[:], privateKey)
+	assert.NoError(t, err)
+	assert.Equal(t, orderSignature[:64], rawSignature[:64])
+
+	recovered, err = builder.RecoverOrderSigner(order, rawSignature, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, signerAddress, recovered)
+
+	// other key
+	otherKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	rawSignature, err = crypto.Sign(orderHash[:], otherKey)
+	assert.NoError(t, err)
+
+	recovered, err = builder.RecoverOrderSigner(order, rawSignature, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(otherKey.PublicKey), recovered)
+
+	// wrong signature length
+	_, err = builder.RecoverOrderSigner(order, orderSignature[:10], model.CTFExchange)
+	assert.ErrorIs(t, err, ErrInvalidSignatureLen)
+}
+
+func TestDefaultSaltRange(t *testing.T) {
+	orderData := &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	// the default salts stay in the range of the official clients
+	builder := NewExchangeOrderBuilder()
+	upper := big.NewInt(time.Now().Unix() + 1)
+	for i := 0; i < 1000; i++ {
+		order, err := builder.BuildOrder(orderData)
+		assert.NoError(t, err)
+		assert.Positive(t, order.Salt.Sign())
+		assert.LessOrEqual(t, order.Salt.Cmp(upper), 0, order.Salt.String())
+	}
+
+	// custom range
+	builder = NewExchangeOrderBuilder(WithSaltRange(10))
+	for i := 0; i < 100; i++ {
+		order, err := builder.BuildOrder(orderData)
+		assert.NoError(t, err)
+		assert.True(t, order.Salt.IsInt64() && order.Salt.Int64() >= 1 && order.Salt.Int64() <= 10, order.Salt.String())
+	}
+}
+
+func TestWithSaltGenerator(t *testing.T) {
+	bigSalt, _ := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+	builder := NewExchangeOrderBuilderImpl(chainId, nil, WithSaltGenerator(func() *big.Int { return bigSalt }))
+
+	orderData := &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	order1, err := builder.BuildOrder(orderData)
+	assert.NoError(t, err)
+	assert.Equal(t, bigSalt.String(), order1.Salt.String())
+
+	order2, err := builder.BuildOrder(orderData)
+	assert.NoError(t, err)
+
+	// identical salt and fields produce identical hashes
+	orderHash1, err := builder.BuildOrderHash(order1, model.CTFExchange)
+	assert.NoError(t, err)
+	orderHash2, err := builder.BuildOrderHash(order2, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, orderHash1, orderHash2)
+
+	// the salt is copied into the order
+	order1.Salt.SetInt64(1)
+	assert.NotEqual(t, bigSalt.String(), order1.Salt.String())
+
+	// the option takes precedence over the int64 generator
+	builder = NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt }, WithSaltGenerator(func() *big.Int { return big.NewInt(7) }))
+
+	order, err := builder.BuildOrder(orderData)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), order.Salt.Int64())
+
+	// nil salt
+	builder = NewExchangeOrderBuilderImpl(chainId, nil, WithSaltGenerator(func() *big.Int { return nil }))
+
+	_, err = builder.BuildOrder(orderData)
+	assert.Error(t, err)
+}
+
+func TestWithTickSize(t *testing.T) {
+	for _, c := range []struct {
+		tickSize    string
+		makerAmount string
+		price       string
+		offTick     string
+	}{
+		{"0.1", "50000000", "0.5", "0.55"},
+		{"0.01", "52000000", "0.52", "0.525"},
+		{"0.001", "52300000", "0.523", "0.5235"},
+	} {
+		builder := NewExchangeOrderBuilderImpl(chainId, nil, WithTickSize(decimal.RequireFromString(c.tickSize)))
+
+		order, err := builder.BuildOrder(&model.OrderData{
+			Maker:       signerAddress.Hex(),
+			Taker:       common.HexToAddress("0x0").Hex(),
+			TokenId:     "1234",
+			MakerAmount: c.makerAmount,
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       "0",
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, order)
+
+		_, err = builder.BuildOrder(&model.OrderData{
+			Maker:       signerAddress.Hex(),
+			Taker:       common.HexToAddress("0x0").Hex(),
+			TokenId:     "1234",
+			MakerAmount: "100000000",
+			TakerAmount: decimal.RequireFromString(c.offTick).Shift(8).String(),
+			Side:        model.SELL,
+			FeeRateBps:  "100",
+			Nonce:       "0",
+		})
+		assert.ErrorIs(t, err, model.ErrPriceNotOnTick, c.tickSize)
+
+		order, err = builder.BuildMarketOrder(&model.MarketOrderData{
+			Maker:      signerAddress.Hex(),
+			Taker:      common.HexToAddress("0x0").Hex(),
+			TokenId:    "1234",
+			Amount:     "1",
+			Price:      c.price,
+			Side:       model.BUY,
+			FeeRateBps: "100",
+			Nonce:      "0",
+		}, model.CTFExchange)
+		assert.NoError(t, err)
+		assert.NotNil(t, order)
+
+		_, err = builder.BuildMarketOrder(&model.MarketOrderData{
+			Maker:      signerAddress.Hex(),
+			Taker:      common.HexToAddress("0x0").Hex(),
+			TokenId:    "1234",
+			Amount:     "1",
+			Price:      c.offTick,
+			Side:       model.BUY,
+			FeeRateBps: "100",
+			Nonce:      "0",
+		}, model.CTFExchange)
+		assert.ErrorIs(t, err, model.ErrPriceNotOnTick, c.tickSize)
+	}
+}
+
+func TestWithChainConfig(t *testing.T) {
+	orderData := &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	// amoy config on a builder created for mainnet
+	builder := NewExchangeOrderBuilderImpl(big.NewInt(137), func() int64 { return salt }, WithChainConfig(model.AmoyChainConfig()))
+
+	order, err := builder.BuildOrder(orderData)
+	assert.NoError(t, err)
+
+	orderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToHash("5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b"), orderHash)
+
+	goldenOrderHash, err := builder.BuildOrderHash(goldenOrder(), model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToHash("02ca1d1aa31103804173ad1acd70066cb6c1258a4be6dada055111f9a7ea4e55"), goldenOrderHash)
+
+	// mainnet config
+	builder = NewExchangeOrderBuilderImpl(nil, func() int64 { return salt }, WithChainConfig(model.MainnetChainConfig()))
+
+	domainSeparator, err := builder.DomainSeparator(model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1a573e3617c78403b5b4b892827992f027b03d4eaf570048b8ee8cdd84d151be", domainSeparator.Hex())
+
+	mainnetOrderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.NotEqual(t, orderHash, mainnetOrderHash)
+
+	// custom deployment
+	chainConfig := model.AmoyChainConfig()
+	chainConfig.ExchangeAddress = common.HexToAddress("0x1")
+	builder = NewExchangeOrderBuilderImpl(nil, func() int64 { return salt }, WithChainConfig(chainConfig))
+
+	customOrderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.NotEqual(t, orderHash, customOrderHash)
+
+	// the builder keeps its own copy of the config
+	chainConfig.ExchangeAddress = common.HexToAddress("0x2")
+	sameOrderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, customOrderHash, sameOrderHash)
+}
+
+func TestBuildOrderHashNegRisk(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	order, err := builder.BuildOrder(&model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	})
+	assert.NoError(t, err)
+
+	orderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+
+	negRiskOrderHash, err := builder.BuildOrderHash(order, model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+
+	// identical order fields hash differently on each exchange
+	assert.NotEqual(t, orderHash, negRiskOrderHash)
+
+	domainSeparator, err := builder.DomainSeparator(model.CTFExchange)
+	assert.NoError(t, err)
+
+	negRiskDomainSeparator, err := builder.DomainSeparator(model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+	assert.NotEqual(t, domainSeparator, negRiskDomainSeparator)
+
+	// a signature for one exchange doesn't verify on the other
+	ethSigner := ethsig.NewEthPrivateKeySigner(privateKey)
+	negRiskSignature, err := builder.BuildOrderSignature(ethSigner, order, model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+
+	signedOrder := &model.SignedOrder{Order: *order, Signature: negRiskSignature}
+
+	ok, err := builder.VerifySignedOrder(signedOrder, model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestBuildSignedOrderPrivateKeySigner(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	signedOrder, err := builder.BuildSignedOrder(signer.NewPrivateKeySigner(privateKey), &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.NotNil(t, signedOrder)
+
+	expectedSignature := "13e2d3ba622dc5ab9813e47dd9c66691437a2e3262af596e8fc8b46ab105bd375f9d3626b9bee506d48990e1418f3434251d39e22269795b16d1a126ae6f19dd1c"
+	assert.Equal(t, expectedSignature, common.Bytes2Hex(signedOrder.Signature))
+}
+
+func TestBuildSignedOrderFuncSigner(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	// the builder only hands the EIP712 digest to the signer, as a remote signer would receive it
+	remoteSigner := signer.NewFuncSigner(signerAddress, func(hash []byte) ([]byte, error) {
+		return crypto.Sign(hash, privateKey)
+	})
+
+	signedOrder, err := builder.BuildSignedOrder(remoteSigner, &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.NotNil(t, signedOrder)
+
+	expectedSignature := "13e2d3ba622dc5ab9813e47dd9c66691437a2e3262af596e8fc8b46ab105bd375f9d3626b9bee506d48990e1418f3434251d39e22269795b16d1a126ae6f19dd1c"
+	assert.Equal(t, expectedSignature, common.Bytes2Hex(signedOrder.Signature))
+
+	valid, err := builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestBuildSignedOrderContext(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	orderData := &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	signedOrder, err := builder.BuildSignedOrderContext(context.Background(), signer.NewPrivateKeySigner(privateKey), orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	expectedSignature := "13e2d3ba622dc5ab9813e47dd9c66691437a2e3262af596e8fc8b46ab105bd375f9d3626b9bee506d48990e1418f3434251d39e22269795b16d1a126ae6f19dd1c"
+	assert.Equal(t, expectedSignature, common.Bytes2Hex(signedOrder.Signature))
+
+	// cancelled before signing
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	signedOrder, err = builder.BuildSignedOrderContext(ctx, signer.NewPrivateKeySigner(privateKey), orderData, model.CTFExchange)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, signedOrder)
+
+	// a slow remote signer is interrupted once the deadline is exceeded
+	slowSigner := signer.NewContextFuncSigner(signerAddress, func(ctx context.Context, hash []byte) ([]byte, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Second):
+			return crypto.Sign(hash, privateKey)
+		}
+	})
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	signedOrder, err = builder.BuildSignedOrderContext(ctx, slowSigner, orderData, model.CTFExchange)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, signedOrder)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestBuildSignedOrderWithHash(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	orderData := &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	expectedHash, err := builder.OrderID(orderData, model.CTFExchange)
+	assert.NoError(t, err)
+
+	// the digest is handed once to signers accepting it
+	var digests []common.Hash
+	funcSigner := signer.NewFuncSigner(signerAddress, func(hash []byte) ([]byte, error) {
+		digests = append(digests, common.BytesToHash(hash))
+		return crypto.Sign(hash, privateKey)
+	})
+	signedOrder, orderHash, err := builder.BuildSignedOrderWithHash(funcSigner, orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHash, orderHash)
+	assert.Equal(t, expectedHash, signedOrder.OrderHash)
+	assert.Equal(t, []common.Hash{expectedHash}, digests)
+
+	expectedSignature := "13e2d3ba622dc5ab9813e47dd9c66691437a2e3262af596e8fc8b46ab105bd375f9d3626b9bee506d48990e1418f3434251d39e22269795b16d1a126ae6f19dd1c"
+	assert.Equal(t, expectedSignature, common.Bytes2Hex(signedOrder.Signature))
+
+	// other signers sign the typed data
+	signedOrder, orderHash, err = builder.BuildSignedOrderWithHash(ethsig.NewEthPrivateKeySigner(privateKey), orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHash, orderHash)
+	assert.Equal(t, expectedSignature, common.Bytes2Hex(signedOrder.Signature))
+
+	_, _, err = builder.BuildSignedOrderWithHash(funcSigner, &model.OrderData{}, model.CTFExchange)
+	assert.Error(t, err)
+}
+
+func TestAttachSignature(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	orderData := &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	order, err := builder.BuildOrder(orderData)
+	assert.NoError(t, err)
+	orderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+
+	// signed elsewhere with a 0/1 recovery id
+	signature, err := crypto.Sign(orderHash[:], privateKey)
+	assert.NoError(t, err)
+
+	signedOrder, err := builder.AttachSignature(order, signature, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, *order, signedOrder.Order)
+	assert.Equal(t, orderHash, signedOrder.OrderHash)
+	expectedSignature := "13e2d3ba622dc5ab9813e47dd9c66691437a2e3262af596e8fc8b46ab105bd375f9d3626b9bee506d48990e1418f3434251d39e22269795b16d1a126ae6f19dd1c"
+	assert.Equal(t, expectedSignature, common.Bytes2Hex(signedOrder.Signature))
+
+	valid, err := builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// signed by another key
+	otherKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	otherSignature, err := crypto.Sign(orderHash[:], otherKey)
+	assert.NoError(t, err)
+	_, err = builder.AttachSignature(order, otherSignature, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrInvalidSignature)
+
+	// signed for another exchange
+	negRiskHash, err := builder.BuildOrderHash(order, model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+	negRiskSignature, err := crypto.Sign(negRiskHash[:], privateKey)
+	assert.NoError(t, err)
+	_, err = builder.AttachSignature(order, negRiskSignature, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrInvalidSignature)
+
+	_, err = builder.AttachSignature(order, signature[:64], model.CTFExchange)
+	assert.ErrorIs(t, err, ErrInvalidSignatureLen)
+
+	// contract signatures are attached as is
+	contractOrder := *order
+	contractOrder.SignatureType = model.SignatureTypePoly1271.Int()
+	contractSignature := []byte{0x01, 0x02, 0x03}
+	signedOrder, err = builder.AttachSignature(&contractOrder, contractSignature, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, contractSignature, []byte(signedOrder.Signature))
+}
+
+func TestBuildSignedOrderRoundRobinSigner(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, nil)
+
+	otherKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	first, second := signer.NewPrivateKeySigner(privateKey), signer.NewPrivateKeySigner(otherKey)
+	roundRobin, err := signer.NewRoundRobinSigner(first, second)
+	assert.NoError(t, err)
+
+	// the maker and signer are left empty, each order is made by the signer picked for it
+	orders := make([]*model.OrderData, 4)
+	for i := range orders {
+		orders[i] = &model.OrderData{
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       "0",
+		}
+	}
+	for i, orderData := range orders {
+		signedOrder, err := builder.BuildSignedOrder(roundRobin, orderData, model.CTFExchange)
+		assert.NoError(t, err)
+
+		expected := []common.Address{first.GetAddress(), second.GetAddress()}[i%2]
+		assert.Equal(t, expected, signedOrder.Signer)
+		assert.Equal(t, expected, signedOrder.Maker)
+
+		valid, err := builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	// concurrent batches spread over both signers
+	signedOrders, err := builder.BuildSignedOrders(roundRobin, orders, model.CTFExchange)
+	assert.NoError(t, err)
+	signers := map[common.Address]int{}
+	for _, signedOrder := range signedOrders {
+		valid, err := builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+		signers[signedOrder.Signer]++
+	}
+	assert.Equal(t, 2, signers[first.GetAddress()])
+	assert.Equal(t, 2, signers[second.GetAddress()])
+
+	// an order with an explicit signer is signed by that signer
+	order, err := builder.BuildOrder(&model.OrderData{
+		Maker:       second.GetAddress().Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	})
+	assert.NoError(t, err)
+	for range 2 {
+		signature, err := builder.BuildOrderSignature(roundRobin, order, model.CTFExchange)
+		assert.NoError(t, err)
+		recovered, err := builder.RecoverOrderSigner(order, signature, model.CTFExchange)
+		assert.NoError(t, err)
+		assert.Equal(t, second.GetAddress(), recovered)
+	}
+}
+
+func TestNewExchangeOrderBuilder(t *testing.T) {
+	// mainnet defaults
+	builder := NewExchangeOrderBuilder()
+
+	domainSeparator, err := builder.DomainSeparator(model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1a573e3617c78403b5b4b892827992f027b03d4eaf570048b8ee8cdd84d151be", domainSeparator.Hex())
+
+	order, err := builder.BuildOrder(&model.OrderData{
+		Maker:       signerAddress.Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, order.Salt)
+
+	// same hash as the positional constructor
+	builder = NewExchangeOrderBuilder(
+		WithChainConfig(model.AmoyChainConfig()),
+		WithSaltGenerator(func() *big.Int { return big.NewInt(salt) }),
+	)
+
+	order, err = builder.BuildOrder(&model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	})
+	assert.NoError(t, err)
+
+	orderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToHash("5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b"), orderHash)
+}
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func TestWithClock(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt }, WithClock(fixedClock(now)))
+
+	orderData := func(expiration string) *model.OrderData {
+		return &model.OrderData{
+			Maker:       signerAddress.Hex(),
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       "0",
+			Expiration:  expiration,
+		}
+	}
+
+	// no expiration
+	_, err := builder.BuildOrder(orderData("0"))
+	assert.NoError(t, err)
+
+	// in the future
+	order, err := builder.BuildOrder(orderData("1700000060"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1700000060", order.Expiration.String())
+
+	// now, still fillable on chain until block.timestamp > expiration
+	order, err = builder.BuildOrder(orderData("1700000000"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1700000000", order.Expiration.String())
+
+	// in the past
+	_, err = builder.BuildOrder(orderData("1699999999"))
+	assert.ErrorIs(t, err, model.ErrExpired)
+
+	// the default clock is the real time
+	_, err = NewExchangeOrderBuilder().BuildOrder(orderData("1700000060"))
+	assert.ErrorIs(t, err, model.ErrExpired)
+}
+
+func TestBuildOrderErrors(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt },
+		WithClock(fixedClock(now)),
+		WithTickSize(decimal.RequireFromString("0.01")),
+	)
+
+	validOrderData := func() *model.OrderData {
+		return &model.OrderData{
+			Maker:       signerAddress.Hex(),
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       "0",
+		}
+	}
+
+	_, err := builder.BuildOrder(validOrderData())
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		modify   func(*model.OrderData)
+		expected error
+	}{
+		{
+			name:     "invalid signature type",
+			modify:   func(o *model.OrderData) { o.SignatureType = 4 },
+			expected: model.ErrInvalidSignatureType,
+		},
+		{
+			name: "proxy signed by maker",
+			modify: func(o *model.OrderData) {
+				o.SignatureType = model.SignatureTypePolyProxy
+			},
+			expected: model.ErrInvalidSigner,
+		},
+		{
+			name:     "invalid side",
+			modify:   func(o *model.OrderData) { o.Side = 2 },
+			expected: model.ErrInvalidSide,
+		},
+		{
+			name:     "zero maker amount",
+			modify:   func(o *model.OrderData) { o.MakerAmount = "0" },
+			expected: model.ErrZeroAmount,
+		},
+		{
+			name:     "zero taker amount",
+			modify:   func(o *model.OrderData) { o.TakerAmount = "0" },
+			expected: model.ErrZeroAmount,
+		},
+		{
+			name:     "fee over max",
+			modify:   func(o *model.OrderData) { o.FeeRateBps = "1001" },
+			expected: model.ErrInvalidFeeRate,
+		},
+		{
+			name:     "token id at 2^256",
+			modify:   func(o *model.OrderData) { o.TokenId = new(big.Int).Lsh(big.NewInt(1), 256).String() },
+			expected: model.ErrInvalidTokenId,
+		},
+		{
+			name:     "expired",
+			modify:   func(o *model.OrderData) { o.Expiration = "1699999999" },
+			expected: model.ErrExpired,
+		},
+		{
+			name:     "price not on tick",
+			modify:   func(o *model.OrderData) { o.MakerAmount = "50500000" },
+			expected: model.ErrPriceNotOnTick,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderData := validOrderData()
+			tt.modify(orderData)
+
+			_, err := builder.BuildOrder(orderData)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+
+	// invalid salt
+	_, err = NewExchangeOrderBuilder(WithSaltGenerator(func() *big.Int { return big.NewInt(-1) })).BuildOrder(validOrderData())
+	assert.ErrorIs(t, err, model.ErrInvalidSalt)
+
+	// invalid contract
+	_, err = builder.BuildSignedOrder(signer.NewPrivateKeySigner(privateKey), validOrderData(), model.VerifyingContract(99))
+	assert.ErrorIs(t, err, model.ErrInvalidContract)
+}
+
+func TestBuildOrderMaxFeeRate(t *testing.T) {
+	orderData := func(feeRateBps string) *model.OrderData {
+		return &model.OrderData{
+			Maker:       signerAddress.Hex(),
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  feeRateBps,
+			Nonce:       "0",
+		}
+	}
+
+	// defaults to the exchange cap
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	_, err := builder.BuildOrder(orderData("1000"))
+	assert.NoError(t, err)
+	_, err = builder.BuildOrder(orderData("1001"))
+	assert.ErrorIs(t, err, model.ErrFeeTooHigh)
+
+	// chain cap
+	chainConfig := model.AmoyChainConfig()
+	chainConfig.MaxFeeRateBps = 500
+	builder = NewExchangeOrderBuilder(WithChainConfig(chainConfig))
+	_, err = builder.BuildOrder(orderData("500"))
+	assert.NoError(t, err)
+	_, err = builder.BuildOrder(orderData("501"))
+	assert.ErrorIs(t, err, model.ErrFeeTooHigh)
+
+	// the market cap overrides the chain's, including a zero cap
+	builder = NewExchangeOrderBuilder(WithChainConfig(chainConfig), WithMaxFeeRateBps(0))
+	_, err = builder.BuildOrder(orderData("0"))
+	assert.NoError(t, err)
+	_, err = builder.BuildOrder(orderData("1"))
+	assert.ErrorIs(t, err, model.ErrFeeTooHigh)
+
+	_, err = builder.BuildMarketOrder(&model.MarketOrderData{
+		Maker:      signerAddress.Hex(),
+		TokenId:    "1234",
+		Amount:     "100",
+		Price:      "0.5",
+		FeeRateBps: "100",
+		Nonce:      "0",
+		Side:       model.BUY,
+	}, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrFeeTooHigh)
+}
+
+func TestOrderID(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	orderData := func() *model.OrderData {
+		return &model.OrderData{
+			Maker:       signerAddress.Hex(),
+			Taker:       common.HexToAddress("0x0").Hex(),
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       "0",
+		}
+	}
+
+	for _, contract := range []model.VerifyingContract{model.CTFExchange, model.NegRiskCTFExchange} {
+		orderID, err := builder.OrderID(orderData(), contract)
+		assert.NoError(t, err)
+
+		order, err := builder.BuildOrder(orderData())
+		assert.NoError(t, err)
+		orderHash, err := builder.BuildOrderHash(order, contract)
+		assert.NoError(t, err)
+		assert.Equal(t, orderHash, orderID)
+
+		signedOrder, err := builder.BuildSignedOrder(signer.NewPrivateKeySigner(privateKey), orderData(), contract)
+		assert.NoError(t, err)
+		assert.Equal(t, signedOrder.OrderHash, orderID)
+	}
+
+	orderID, err := builder.OrderID(orderData(), model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToHash("5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b"), orderID)
+
+	_, err = builder.OrderID(orderData(), model.VerifyingContract(99))
+	assert.ErrorIs(t, err, model.ErrInvalidContract)
+}
+
+func TestBuildOrderTypedData(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	order, err := builder.BuildOrder(&model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	})
+	assert.NoError(t, err)
+
+	for _, contract := range []model.VerifyingContract{model.CTFExchange, model.NegRiskCTFExchange} {
+		typedData, err := builder.BuildOrderTypedData(order, contract)
+		assert.NoError(t, err)
+		assert.Equal(t, "Order", typedData.PrimaryType)
+		assert.Equal(t, signerAddress.Hex(), typedData.Message["maker"])
+
+		orderHash, err := builder.BuildOrderHash(order, contract)
+		assert.NoError(t, err)
+
+		hash, _, err := apitypes.TypedDataAndHash(*typedData)
+		assert.NoError(t, err)
+		assert.Equal(t, orderHash.Bytes(), hash)
+
+		// round trip through the eth_signTypedData_v4 JSON
+		raw, err := json.Marshal(typedData)
+		assert.NoError(t, err)
+
+		var decoded apitypes.TypedData
+		assert.NoError(t, json.Unmarshal(raw, &decoded))
+		hash, _, err = apitypes.TypedDataAndHash(decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, orderHash.Bytes(), hash)
+	}
+
+	_, err = builder.BuildOrderTypedData(order, model.VerifyingContract(99))
+	assert.ErrorIs(t, err, model.ErrInvalidContract)
+}
+
+func TestBuildOrderTypedDataJSON(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	order, err := builder.BuildOrder(&model.OrderData{
+		Maker:       signerAddress.Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	})
+	assert.NoError(t, err)
+
+	for _, contract := range []model.VerifyingContract{model.CTFExchange, model.NegRiskCTFExchange} {
+		raw, err := builder.BuildOrderTypedDataJSON(order, contract)
+		assert.NoError(t, err)
+
+		var fields map[string]json.RawMessage
+		assert.NoError(t, json.Unmarshal(raw, &fields))
+		assert.Len(t, fields, 4)
+		assert.JSONEq(t, `"Order"`, string(fields["primaryType"]))
+		assert.Contains(t, string(fields["types"]), `"EIP712Domain"`)
+		assert.Contains(t, string(fields["domain"]), `"chainId":80002`)
+		assert.Contains(t, string(fields["message"]), `"salt":"479249096354"`)
+
+		// hashing the JSON the way wallets do gives the order hash
+		var typedData apitypes.TypedData
+		assert.NoError(t, json.Unmarshal(raw, &typedData))
+		digest, _, err := apitypes.TypedDataAndHash(typedData)
+		assert.NoError(t, err)
+
+		orderHash, err := builder.BuildOrderHash(order, contract)
+		assert.NoError(t, err)
+		assert.Equal(t, orderHash.Bytes(), digest)
+
+		// the wallet signature attaches to the order
+		signature, err := crypto.Sign(digest, privateKey)
+		assert.NoError(t, err)
+		signedOrder, err := builder.AttachSignature(order, signature, contract)
+		assert.NoError(t, err)
+		valid, err := builder.VerifySignedOrder(signedOrder, contract)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	_, err = builder.BuildOrderTypedDataJSON(order, model.VerifyingContract(99))
+	assert.ErrorIs(t, err, model.ErrInvalidContract)
+}
+
+func TestExplain(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	orderData := &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	explanation, err := builder.Explain(orderData, model.CTFExchange)
+	assert.NoError(t, err)
+
+	order, err := builder.BuildOrder(orderData)
+	assert.NoError(t, err)
+	assert.Equal(t, order, explanation.Order)
+
+	assert.Equal(t, "Polymarket CTF Exchange", explanation.DomainName)
+	assert.Equal(t, "1", explanation.DomainVersion)
+	assert.Equal(t, int64(80002), explanation.ChainID.Int64())
+	assert.Equal(t, common.HexToAddress("0xdFE02Eb6733538f8Ea35D585af8DE5958AD99E40"), explanation.VerifyingContract)
+
+	assert.Equal(t, "0x44b180a7e548e2d916b5410176db13a07744966f9b6c93c4bccdabebbcdfca93", explanation.DomainSeparator.Hex())
+	assert.Equal(t, "0x0a951f8b917daf02ea0fb7065757fcf9eac0f49a0b539f38b0385a8cd3263ee9", explanation.StructHash.Hex())
+	assert.Equal(t, "0x5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b", explanation.OrderHash.Hex())
+	assert.Equal(t, crypto.Keccak256Hash([]byte{0x19, 0x01}, explanation.DomainSeparator.Bytes(), explanation.StructHash.Bytes()), explanation.OrderHash)
+
+	assert.JSONEq(t, `{
+		"salt": 479249096354,
+		"maker": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		"signer": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		"taker": "0x0000000000000000000000000000000000000000",
+		"tokenId": "1234",
+		"makerAmount": "50000000",
+		"takerAmount": "100000000",
+		"expiration": "0",
+		"nonce": "0",
+		"feeRateBps": "100",
+		"side": "BUY",
+		"signatureType": 0,
+		"signature": "0x"
+	}`, string(explanation.Payload))
+
+	// the values match the other entrypoints
+	domainSeparator, err := builder.DomainSeparator(model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, domainSeparator, explanation.DomainSeparator)
+	structHash, err := builder.OrderStructHash(order)
+	assert.NoError(t, err)
+	assert.Equal(t, structHash, explanation.StructHash)
+	signedOrder, err := builder.BuildSignedOrder(signer.NewPrivateKeySigner(privateKey), orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, signedOrder.OrderHash, explanation.OrderHash)
+
+	// the neg risk exchange only changes the domain
+	negRisk, err := builder.Explain(orderData, model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, explanation.StructHash, negRisk.StructHash)
+	assert.NotEqual(t, explanation.DomainSeparator, negRisk.DomainSeparator)
+	assert.NotEqual(t, explanation.OrderHash, negRisk.OrderHash)
+
+	_, err = builder.Explain(orderData, model.VerifyingContract(99))
+	assert.ErrorIs(t, err, model.ErrInvalidContract)
+
+	orderData.MakerAmount = "0"
+	_, err = builder.Explain(orderData, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrZeroAmount)
+}
+
+func TestBuildSignedOrderContractWallet(t *testing.T) {
+	wallet := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	orderData := func() *model.OrderData {
+		return &model.OrderData{
+			Maker:         wallet.Hex(),
+			TokenId:       "1234",
+			MakerAmount:   "50000000",
+			TakerAmount:   "100000000",
+			Side:          model.BUY,
+			FeeRateBps:    "100",
+			Nonce:         "0",
+			SignatureType: model.SignatureTypePoly1271,
+		}
+	}
+
+	// the owner of the wallet signs, the signature is not recovered locally
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	ownerSigner := signer.NewPrivateKeySigner(privateKey)
+
+	signedOrder, err := builder.BuildSignedOrder(ownerSigner, orderData(), model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, wallet, signedOrder.Maker)
+	assert.Equal(t, wallet, signedOrder.Signer)
+	assert.Equal(t, "3", signedOrder.SignatureType.String())
+
+	ownerSignature, err := ownerSigner.SignHash(signedOrder.OrderHash)
+	assert.NoError(t, err)
+	assert.Equal(t, ownerSignature, signedOrder.Signature)
+
+	_, err = builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrContractSignature)
+
+	_, err = builder.RecoverOrderSigner(&signedOrder.Order, signedOrder.Signature, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrContractSignature)
+
+	// wallet specific encoding
+	builder = NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt }, WithContractSignaturePacker(func(orderHash common.Hash, signature []byte) ([]byte, error) {
+		assert.Equal(t, signedOrder.OrderHash, orderHash)
+		return append([]byte{0x01}, signature...), nil
+	}))
+
+	packedOrder, err := builder.BuildSignedOrder(ownerSigner, orderData(), model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, signedOrder.OrderHash, packedOrder.OrderHash)
+	assert.Equal(t, append([]byte{0x01}, ownerSignature...), packedOrder.Signature)
+
+	// the wallet must be the signer
+	invalidOrderData := orderData()
+	invalidOrderData.Signer = signerAddress.Hex()
+	_, err = builder.BuildSignedOrder(ownerSigner, invalidOrderData, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrInvalidSigner)
+}
+
+func TestBuildSignedOrderConcurrent(t *testing.T) {
+	builder := NewExchangeOrderBuilder(WithChainConfig(model.AmoyChainConfig()))
+	s := signer.NewPrivateKeySigner(privateKey)
+
+	// the same order data is shared by all the goroutines
+	orderData := &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	const goroutines = 32
+	signedOrders := make([]*model.SignedOrder, goroutines)
+	errs := make([]error, goroutines)
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			contract := model.CTFExchange
+			if i%2 == 1 {
+				contract = model.NegRiskCTFExchange
+			}
+			signedOrders[i], errs[i] = builder.BuildSignedOrder(s, orderData, contract)
+		}(i)
+	}
+	wg.Wait()
+
+	hashes := make(map[common.Hash]bool)
+	for i, signedOrder := range signedOrders {
+		assert.NoError(t, errs[i])
+
+		contract := model.CTFExchange
+		if i%2 == 1 {
+			contract = model.NegRiskCTFExchange
+		}
+		valid, err := builder.VerifySignedOrder(signedOrder, contract)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+
+		hashes[signedOrder.OrderHash] = true
+	}
+	assert.Len(t, hashes, goroutines)
+	assert.Equal(t, "", orderData.Expiration)
+}
+
+func TestSameHash(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	orderData := func() *model.OrderData {
+		return &model.OrderData{
+			Maker:       signerAddress.Hex(),
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       "0",
+		}
+	}
+
+	a, err := builder.BuildOrder(orderData())
+	assert.NoError(t, err)
+	b, err := builder.BuildOrder(orderData())
+	assert.NoError(t, err)
+	assert.NotSame(t, a.Salt, b.Salt)
+
+	same, err := builder.SameHash(a, b, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.True(t, same)
+	assert.True(t, a.Equal(b))
+
+	b.Nonce = big.NewInt(1)
+	same, err = builder.SameHash(a, b, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.False(t, same)
+	assert.False(t, a.Equal(b))
+
+	_, err = builder.SameHash(a, b, model.VerifyingContract(99))
+	assert.ErrorIs(t, err, model.ErrInvalidContract)
+}
+
+func TestBuildOrderExpirationFromDuration(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt }, WithClock(fixedClock(now)))
+
+	expiration, err := model.GTDExpiration(5*time.Minute, now)
+	assert.NoError(t, err)
+	order, err := builder.BuildOrder(&model.OrderData{
+		Maker:       signerAddress.Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+		Expiration:  expiration.String(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1700000360", order.Expiration.String())
+
+	// negative durations are rejected, even when the security buffer or the truncation
+	// to seconds would leave the expiration at or after now
+	for _, d := range []time.Duration{-2 * time.Minute, -30 * time.Second, -500 * time.Millisecond} {
+		_, err = model.GTDExpiration(d, now)
+		assert.ErrorIs(t, err, model.ErrInvalidExpiration, d)
+
+		_, err = model.ExpirationFromDuration(d, now)
+		assert.ErrorIs(t, err, model.ErrInvalidExpiration, d)
+	}
+}
+
+func TestBuildSignedOrderLowS(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	// a signer producing malleated high-S signatures
+	highSSigner := signer.NewFuncSigner(signerAddress, func(hash []byte) ([]byte, error) {
+		signature, err := crypto.Sign(hash, privateKey)
+		if err != nil {
+			return nil, err
+		}
+		s := new(big.Int).SetBytes(signature[32:64])
+		new(big.Int).Sub(crypto.S256().Params().N, s).FillBytes(signature[32:64])
+		signature[64] ^= 1
+		return signature, nil
+	})
+
+	orderData := &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	expectedSignature := "13e2d3ba622dc5ab9813e47dd9c66691437a2e3262af596e8fc8b46ab105bd375f9d3626b9bee506d48990e1418f3434251d39e22269795b16d1a126ae6f19dd1c"
+
+	signedOrder, err := builder.BuildSignedOrder(highSSigner, orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSignature, common.Bytes2Hex(signedOrder.Signature))
+
+	signature, err := builder.BuildOrderSignature(highSSigner, &signedOrder.Order, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSignature, common.Bytes2Hex(signature))
+}
+
+func TestBuildOrderTaker(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	orderData := func(taker string) *model.OrderData {
+		return &model.OrderData{
+			Maker:       signerAddress.Hex(),
+			Taker:       taker,
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       "0",
+		}
+	}
+
+	// an empty taker is a public order
+	publicOrderHash, err := builder.OrderID(orderData(""), model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, common.HexToHash("5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b"), publicOrderHash)
+
+	order, err := builder.BuildOrder(orderData(""))
+	assert.NoError(t, err)
+	assert.Equal(t, common.Address{}, order.Taker)
+
+	// the taker is part of the EIP712 struct
+	counterparty := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	order, err = builder.BuildOrder(orderData(counterparty.Hex()))
+	assert.NoError(t, err)
+	assert.Equal(t, counterparty, order.Taker)
+
+	privateOrderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.NotEqual(t, publicOrderHash, privateOrderHash)
+
+	_, err = builder.BuildOrder(orderData("0x1234"))
+	assert.ErrorIs(t, err, model.ErrInvalidTaker)
+}
+
+func TestBuildMarketOrderCollateralDecimals(t *testing.T) {
+	chainConfig := model.AmoyChainConfig()
+	chainConfig.CollateralDecimals = 18
+
+	builder := NewExchangeOrderBuilder(
+		WithChainConfig(chainConfig),
+		WithSaltGenerator(func() *big.Int { return big.NewInt(salt) }),
+		WithTickSize(decimal.RequireFromString("0.01")),
+	)
+
+	// buy: spend 100 collateral at 0.5
+	order, err := builder.BuildMarketOrder(&model.MarketOrderData{
+		Maker:      signerAddress.Hex(),
+		TokenId:    "1234",
+		Amount:     "100",
+		Price:      "0.5",
+		FeeRateBps: "100",
+		Nonce:      "0",
+		Side:       model.BUY,
+	}, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, "100000000000000000000", order.MakerAmount.String())
+	assert.Equal(t, "200000000", order.TakerAmount.String())
+
+	// the implied price of limit orders uses the configured decimals
+	_, err = builder.BuildOrder(&model.OrderData{
+		Maker:       signerAddress.Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000000000000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	})
+	assert.NoError(t, err)
+}
+
+func TestBuildSignedOrderPolyProxyMaker(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(big.NewInt(137), func() int64 { return salt })
+	s := signer.NewPrivateKeySigner(privateKey)
+
+	orderData := &model.OrderData{
+		TokenId:       "1234",
+		MakerAmount:   "50000000",
+		TakerAmount:   "100000000",
+		Side:          model.BUY,
+		FeeRateBps:    "100",
+		Nonce:         "0",
+		SignatureType: model.SignatureTypePolyProxy,
+	}
+
+	signedOrder, err := builder.BuildSignedOrder(s, orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, model.DerivePolyProxyAddress(signerAddress), signedOrder.Maker)
+	assert.Equal(t, signerAddress, signedOrder.Signer)
+	assert.Equal(t, "", orderData.Maker)
+
+	valid, err := builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestBuildSignedOrderGnosisSafeMaker(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(big.NewInt(137), func() int64 { return salt })
+	s := signer.NewPrivateKeySigner(privateKey)
+
+	orderData := &model.OrderData{
+		TokenId:       "1234",
+		MakerAmount:   "50000000",
+		TakerAmount:   "100000000",
+		Side:          model.BUY,
+		FeeRateBps:    "100",
+		Nonce:         "0",
+		SignatureType: model.SignatureTypePolyGnosisSafe,
+	}
+
+	signedOrder, err := builder.BuildSignedOrder(s, orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, model.DeriveGnosisSafeAddress(signerAddress), signedOrder.Maker)
+	assert.Equal(t, signerAddress, signedOrder.Signer)
+
+	// no proxy wallet factory on amoy
+	orderData.SignatureType = model.SignatureTypePolyProxy
+	_, err = NewExchangeOrderBuilderImpl(chainId, nil).BuildSignedOrder(s, orderData, model.CTFExchange)
+	assert.Error(t, err)
+}
+
+func buildTestOrders(t testing.TB, builder *ExchangeOrderBuilderImpl, n int) []*model.Order {
+	orders := make([]*model.Order, n)
+	for i := range orders {
+		order, err := builder.BuildOrder(&model.OrderData{
+			Maker:       signerAddress.Hex(),
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        model.BUY,
+			FeeRateBps:  "100",
+			Nonce:       fmt.Sprintf("%d", i),
+		})
+		assert.NoError(t, err)
+		orders[i] = order
+	}
+	return orders
+}
+
+func TestOrderStructHash(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	order, err := builder.BuildOrder(&model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	})
+	assert.NoError(t, err)
+
+	structHash, err := builder.OrderStructHash(order)
+	assert.NoError(t, err)
+
+	// the struct hash doesn't depend on the chain
+	otherChainStructHash, err := NewExchangeOrderBuilderImpl(big.NewInt(137), nil).OrderStructHash(order)
+	assert.NoError(t, err)
+	assert.Equal(t, structHash, otherChainStructHash)
+
+	for _, contract := range []model.VerifyingContract{model.CTFExchange, model.NegRiskCTFExchange} {
+		domainSeparator, err := builder.DomainSeparator(contract)
+		assert.NoError(t, err)
+
+		orderHash, err := builder.BuildOrderHash(order, contract)
+		assert.NoError(t, err)
+
+		digest := crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator.Bytes(), structHash.Bytes())
+		assert.Equal(t, orderHash, digest)
+	}
+}
+
+func TestWithOrderBuiltHook(t *testing.T) {
+	type builtOrder struct {
+		order *model.Order
+		hash  model.OrderHash
+	}
+	var mu sync.Mutex
+	var built []builtOrder
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt }, WithOrderBuiltHook(func(order *model.Order, hash model.OrderHash) {
+		mu.Lock()
+		defer mu.Unlock()
+		built = append(built, builtOrder{order, hash})
+	}))
+
+	orderData := &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "100",
+		Nonce:       "0",
+	}
+
+	signedOrder, err := builder.BuildSignedOrder(signer.NewPrivateKeySigner(privateKey), orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	if assert.Len(t, built, 1) {
+		assert.Equal(t, common.HexToHash("0x5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b"), built[0].hash)
+		assert.Equal(t, signedOrder.OrderHash, built[0].hash)
+		assert.Equal(t, signerAddress, built[0].order.Signer)
+	}
+
+	// BuildOrder hashes the order for the CTF exchange
+	order, err := builder.BuildOrder(orderData)
+	assert.NoError(t, err)
+	if assert.Len(t, built, 2) {
+		assert.Equal(t, signedOrder.OrderHash, built[1].hash)
+		assert.Equal(t, order, built[1].order)
+	}
+
+	// hash queries don't fire the hook
+	_, err = builder.OrderID(orderData, model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+	_, err = builder.Explain(orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Len(t, built, 2)
+
+	order, err = builder.BuildMarketOrder(&model.MarketOrderData{
+		Maker:      signerAddress.Hex(),
+		TokenId:    "1234",
+		Amount:     "100",
+		Price:      "0.5",
+		Side:       model.BUY,
+		FeeRateBps: "0",
+		Nonce:      "0",
+	}, model.CTFExchange)
+	assert.NoError(t, err)
+	if assert.Len(t, built, 3) {
+		orderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+		assert.NoError(t, err)
+		assert.Equal(t, orderHash, built[2].hash)
+		assert.Equal(t, order, built[2].order)
+	}
+
+	_, err = builder.BuildSignedOrders(signer.NewPrivateKeySigner(privateKey), []*model.OrderData{orderData, orderData}, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Len(t, built, 5)
+
+	// orders failing to build don't fire the hook
+	_, err = builder.BuildOrder(&model.OrderData{TokenId: "1234", MakerAmount: "0", TakerAmount: "1"})
+	assert.Error(t, err)
+	assert.Len(t, built, 5)
+
+	// a nil hook is a no-op
+	builder = NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt }, WithOrderBuiltHook(nil))
+	_, err = builder.BuildSignedOrder(signer.NewPrivateKeySigner(privateKey), orderData, model.CTFExchange)
+	assert.NoError(t, err)
+}
+
+func TestDeriveReplacement(t *testing.T) {
+	builder := NewExchangeOrderBuilder(WithChainConfig(model.AmoyChainConfig()))
+	ethSigner := signer.NewPrivateKeySigner(privateKey)
+
+	prev, err := builder.BuildSignedOrder(ethSigner, &model.OrderData{
+		Maker:       signerAddress.Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "0",
+		Nonce:       "0",
+	}, model.CTFExchange)
+	assert.NoError(t, err)
+
+	// even at the same price and size the replacement gets a fresh salt
+	replacementData, err := model.DeriveReplacement(&prev.Order, decimal.RequireFromString("0.5"), decimal.RequireFromString("100"))
+	assert.NoError(t, err)
+	replacement, err := builder.BuildSignedOrder(ethSigner, replacementData, model.CTFExchange)
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, prev.OrderHash, replacement.OrderHash)
+	assert.NotEqual(t, prev.Salt, replacement.Salt)
+	assert.Equal(t, prev.MakerAmount, replacement.MakerAmount)
+	assert.Equal(t, prev.TakerAmount, replacement.TakerAmount)
+	assert.Equal(t, prev.Maker, replacement.Maker)
+	assert.Equal(t, prev.Signer, replacement.Signer)
+	assert.Equal(t, prev.TokenId, replacement.TokenId)
+	assert.Equal(t, prev.Nonce, replacement.Nonce)
+}
+
+func TestBuildOrderHashes(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, nil)
+	orders := buildTestOrders(t, builder, 10)
+
+	orderHashes, err := builder.BuildOrderHashes(orders, model.NegRiskCTFExchange)
+	assert.NoError(t, err)
+	assert.Len(t, orderHashes, len(orders))
+
+	for i, order := range orders {
+		orderHash, err := builder.BuildOrderHash(order, model.NegRiskCTFExchange)
+		assert.NoError(t, err)
+		assert.Equal(t, orderHash, orderHashes[i])
+	}
+
+	// the malformed order index is reported
+	orders[3] = nil
+	_, err = builder.BuildOrderHashes(orders, model.CTFExchange)
+	assert.ErrorContains(t, err, "order 3")
+
+	_, err = builder.BuildOrderHashes(orders, model.VerifyingContract(99))
+	assert.ErrorIs(t, err, model.ErrInvalidContract)
+
+	orderHashes, err = builder.BuildOrderHashes(nil, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Empty(t, orderHashes)
+}
+
+// Encodes the order as the exchange contract hashes it, with abi.encode of the Order struct fields
+// and the ORDER_TYPEHASH of the Solidity declaration, independently of the EIP712 typed data.
+func abiOrderStructHash(order *model.Order) common.Hash {
+	orderTypeHash := model.OrderTypeHash().Bytes()
+
+	word := func(x *big.Int) []byte { return common.LeftPadBytes(x.Bytes(), 32) }
+	encoded := [][]byte{
+		orderTypeHash,
+		word(order.Salt),
+		common.LeftPadBytes(order.Maker.Bytes(), 32),
+		common.LeftPadBytes(order.Signer.Bytes(), 32),
+		common.LeftPadBytes(order.Taker.Bytes(), 32),
+		word(order.TokenId),
+		word(order.MakerAmount),
+		word(order.TakerAmount),
+		word(order.Expiration),
+		word(order.Nonce),
+		word(order.FeeRateBps),
+		word(order.Side),
+		word(order.SignatureType),
+	}
+	return crypto.Keccak256Hash(encoded...)
+}
+
+func TestOrderHashABIEncoding(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	tokenId, ok := new(big.Int).SetString("71321045679252212594626385532706912750332728571942532289631379312455583992563", 10)
+	assert.True(t, ok)
+
+	orders := []*model.Order{
+		{
+			Salt:          big.NewInt(salt),
+			Maker:         signerAddress,
+			Signer:        signerAddress,
+			Taker:         common.Address{},
+			TokenId:       big.NewInt(1234),
+			MakerAmount:   big.NewInt(50000000),
+			TakerAmount:   big.NewInt(100000000),
+			Expiration:    big.NewInt(0),
+			Nonce:         big.NewInt(0),
+			FeeRateBps:    big.NewInt(100),
+			Side:          model.BUY.Int(),
+			SignatureType: model.SignatureTypeEOA.Int(),
+		},
+		// values using the whole uint256 and uint8 ranges of the exchange's Order struct
+		{
+			Salt:          maxUint256,
+			Maker:         common.HexToAddress("0xaFB8270A801862270FebB3763505b136491e557b"),
+			Signer:        signerAddress,
+			Taker:         common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+			TokenId:       tokenId,
+			MakerAmount:   maxUint256,
+			TakerAmount:   new(big.Int).Lsh(big.NewInt(1), 255),
+			Expiration:    big.NewInt(1735689600),
+			Nonce:         maxUint256,
+			FeeRateBps:    big.NewInt(1000),
+			Side:          model.SELL.Int(),
+			SignatureType: model.SignatureTypePolyGnosisSafe.Int(),
+		},
+	}
+	expectedHashes := []string{
+		"0x5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b",
+		"0x4d525a84230aba79432469cadc141c90ca75464f9ec5ffe0b48f5e04285e92fd",
+	}
+
+	domainSeparator, err := builder.DomainSeparator(model.CTFExchange)
+	assert.NoError(t, err)
+	abiDomainSeparator := crypto.Keccak256Hash(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte("Polymarket CTF Exchange")),
+		crypto.Keccak256([]byte("1")),
+		common.LeftPadBytes(chainId.Bytes(), 32),
+		common.LeftPadBytes(common.HexToAddress("0xdFE02Eb6733538f8Ea35D585af8DE5958AD99E40").Bytes(), 32),
+	)
+	assert.Equal(t, abiDomainSeparator, domainSeparator)
+
+	for i, order := range orders {
+		structHash, err := builder.OrderStructHash(order)
+		assert.NoError(t, err)
+		assert.Equal(t, abiOrderStructHash(order), structHash)
+		assert.Equal(t, crypto.Keccak256Hash(model.OrderTypeHash().Bytes(), order.CanonicalBytes()), structHash)
+
+		orderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+		assert.NoError(t, err)
+		assert.Equal(t, crypto.Keccak256Hash([]byte{0x19, 0x01}, abiDomainSeparator.Bytes(), abiOrderStructHash(order).Bytes()), orderHash)
+		assert.Equal(t, expectedHashes[i], orderHash.Hex())
+
+		// the signature recovers to the signer over the same digest
+		signature, err := builder.BuildOrderSignature(signer.NewPrivateKeySigner(privateKey), order, model.CTFExchange)
+		assert.NoError(t, err)
+		recovered, err := recoverAddress(orderHash, signature)
+		assert.NoError(t, err)
+		assert.Equal(t, signerAddress, recovered)
+	}
+}
+
+func TestDomainSeparatorCache(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	orders := buildTestOrders(t, builder, 1)
+
+	expected := map[model.VerifyingContract]model.OrderHash{}
+	for _, contract := range []model.VerifyingContract{model.CTFExchange, model.NegRiskCTFExchange} {
+		orderHash, err := NewExchangeOrderBuilderImpl(chainId, nil).BuildOrderHash(orders[0], contract)
+		assert.NoError(t, err)
+		expected[contract] = orderHash
+	}
+	assert.NotEqual(t, expected[model.CTFExchange], expected[model.NegRiskCTFExchange])
+
+	// switching between contracts on the same builder
+	for _, contract := range []model.VerifyingContract{model.CTFExchange, model.NegRiskCTFExchange, model.CTFExchange, model.NegRiskCTFExchange} {
+		orderHash, err := builder.BuildOrderHash(orders[0], contract)
+		assert.NoError(t, err)
+		assert.Equal(t, expected[contract], orderHash)
+
+		_, uncached, err := builder.computeDomainSeparator(contract)
+		assert.NoError(t, err)
+		cached, err := builder.DomainSeparator(contract)
+		assert.NoError(t, err)
+		assert.Equal(t, uncached, cached)
+	}
+
+	// the cache is per builder, i.e per chain
+	mainnetHash, err := NewExchangeOrderBuilderImpl(big.NewInt(137), nil).BuildOrderHash(orders[0], model.CTFExchange)
+	assert.NoError(t, err)
+	assert.NotEqual(t, expected[model.CTFExchange], mainnetHash)
+
+	// unknown contracts are not cached
+	_, err = builder.DomainSeparator(model.VerifyingContract(42))
+	assert.ErrorIs(t, err, model.ErrInvalidContract)
+	_, err = builder.DomainSeparator(model.VerifyingContract(42))
+	assert.ErrorIs(t, err, model.ErrInvalidContract)
+}
+
+func BenchmarkDomainSeparator(b *testing.B) {
+	builder := NewExchangeOrderBuilderImpl(chainId, nil)
+
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			if _, _, err := builder.buildDomainSeparator(model.CTFExchange); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			if _, _, err := builder.computeDomainSeparator(model.CTFExchange); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkBuildOrderHash(b *testing.B) {
+	builder := NewExchangeOrderBuilderImpl(chainId, nil)
+	orders := buildTestOrders(b, builder, 500)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, order := range orders {
+			if _, err := builder.BuildOrderHash(order, model.CTFExchange); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkBuildOrderHashes(b *testing.B) {
+	builder := NewExchangeOrderBuilderImpl(chainId, nil)
+	orders := buildTestOrders(b, builder, 500)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := builder.BuildOrderHashes(orders, model.CTFExchange); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestOrderTypesMatchOrderTypeHash(t *testing.T) {
+	fields := make([]string, len(orderTypes["Order"]))
+	for i, field := range orderTypes["Order"] {
+		fields[i] = field.Type + " " + field.Name
+	}
+	encodedType := "Order(" + strings.Join(fields, ",") + ")"
+
+	assert.Equal(t, model.OrderType, encodedType)
+	assert.Equal(t, model.OrderTypeHash(), crypto.Keccak256Hash([]byte(encodedType)))
+}
+
+func TestNilOrderFields(t *testing.T) {
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	order, err := builder.BuildOrder(&model.OrderData{
+		Maker:       signerAddress.Hex(),
+		Taker:       common.HexToAddress("0x0").Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "0",
+		Nonce:       "0",
+	})
+	assert.NoError(t, err)
+	orderHash, err := builder.BuildOrderHash(order, model.CTFExchange)
+	assert.NoError(t, err)
+	signature, err := crypto.Sign(orderHash[:], privateKey)
+	assert.NoError(t, err)
+
+	// optional fields are hashed as zero
+	for name, clear := range map[string]func(o *model.Order){
+		"expiration":    func(o *model.Order) { o.Expiration = nil },
+		"nonce":         func(o *model.Order) { o.Nonce = nil },
+		"feeRateBps":    func(o *model.Order) { o.FeeRateBps = nil },
+		"side":          func(o *model.Order) { o.Side = nil },
+		"signatureType": func(o *model.Order) { o.SignatureType = nil },
+	} {
+		nilOrder := *order
+		clear(&nilOrder)
+
+		hash, err := builder.BuildOrderHash(&nilOrder, model.CTFExchange)
+		assert.NoError(t, err, name)
+		assert.Equal(t, orderHash, hash, name)
+
+		signedOrder, err := builder.AttachSignature(&nilOrder, signature, model.CTFExchange)
+		assert.NoError(t, err, name)
+		valid, err := builder.VerifySignedOrder(signedOrder, model.CTFExchange)
+		assert.NoError(t, err, name)
+		assert.True(t, valid, name)
+	}
+
+	// mandatory fields are rejected
+	for name, clear := range map[string]func(o *model.Order){
+		"salt":        func(o *model.Order) { o.Salt = nil },
+		"tokenId":     func(o *model.Order) { o.TokenId = nil },
+		"makerAmount": func(o *model.Order) { o.MakerAmount = nil },
+		"takerAmount": func(o *model.Order) { o.TakerAmount = nil },
+	} {
+		nilOrder := *order
+		clear(&nilOrder)
+
+		_, err := builder.BuildOrderHash(&nilOrder, model.CTFExchange)
+		assert.ErrorIs(t, err, model.ErrMissingOrderField, name)
+		assert.ErrorContains(t, err, name)
+
+		_, err = builder.BuildOrderHashes([]*model.Order{order, &nilOrder}, model.CTFExchange)
+		assert.ErrorIs(t, err, model.ErrMissingOrderField, name)
+
+		_, err = builder.OrderStructHash(&nilOrder)
+		assert.ErrorIs(t, err, model.ErrMissingOrderField, name)
+
+		_, err = builder.BuildOrderTypedData(&nilOrder, model.CTFExchange)
+		assert.ErrorIs(t, err, model.ErrMissingOrderField, name)
+
+		_, err = builder.BuildOrderSignature(signer.NewPrivateKeySigner(privateKey), &nilOrder, model.CTFExchange)
+		assert.ErrorIs(t, err, model.ErrMissingOrderField, name)
+
+		_, err = builder.RecoverOrderSigner(&nilOrder, signature, model.CTFExchange)
+		assert.ErrorIs(t, err, model.ErrMissingOrderField, name)
+
+		_, err = builder.AttachSignature(&nilOrder, signature, model.CTFExchange)
+		assert.ErrorIs(t, err, model.ErrMissingOrderField, name)
+
+		_, err = builder.VerifySignedOrder(&model.SignedOrder{Order: nilOrder, Signature: signature}, model.CTFExchange)
+		assert.ErrorIs(t, err, model.ErrMissingOrderField, name)
+	}
+
+	// an empty order doesn't panic either
+	_, err = builder.BuildOrderHash(&model.Order{}, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrMissingOrderField)
+}
@@ -1,15 +1,20 @@
-// Code generated by mockery v2.36.1. DO NOT EDIT.
+// Code generated by mockery v2.53.3. DO NOT EDIT.
 
 package mocks
 
 import (
-	ecdsa "crypto/ecdsa"
+	apitypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+	builder "github.com/ivanzzeth/polymarket-go-order-utils/pkg/builder"
 
 	common "github.com/ethereum/go-ethereum/common"
 
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+
+	signer "github.com/ivanzzeth/polymarket-go-order-utils/pkg/signer"
 )
 
 // ExchangeOrderBuilder is an autogenerated mock type for the ExchangeOrderBuilder type
@@ -17,10 +22,141 @@ type ExchangeOrderBuilder struct {
 	mock.Mock
 }
 
+type ExchangeOrderBuilder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExchangeOrderBuilder) EXPECT() *ExchangeOrderBuilder_Expecter {
+	return &ExchangeOrderBuilder_Expecter{mock: &_m.Mock}
+}
+
+// AttachSignature provides a mock function with given fields: order, signature, contract
+func (_m *ExchangeOrderBuilder) AttachSignature(order *model.Order, signature []byte, contract int) (*model.SignedOrder, error) {
+	ret := _m.Called(order, signature, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachSignature")
+	}
+
+	var r0 *model.SignedOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Order, []byte, int) (*model.SignedOrder, error)); ok {
+		return rf(order, signature, contract)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Order, []byte, int) *model.SignedOrder); ok {
+		r0 = rf(order, signature, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SignedOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Order, []byte, int) error); ok {
+		r1 = rf(order, signature, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_AttachSignature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachSignature'
+type ExchangeOrderBuilder_AttachSignature_Call struct {
+	*mock.Call
+}
+
+// AttachSignature is a helper method to define mock.On call
+//   - order *model.Order
+//   - signature []byte
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) AttachSignature(order interface{}, signature interface{}, contract interface{}) *ExchangeOrderBuilder_AttachSignature_Call {
+	return &ExchangeOrderBuilder_AttachSignature_Call{Call: _e.mock.On("AttachSignature", order, signature, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_AttachSignature_Call) Run(run func(order *model.Order, signature []byte, contract int)) *ExchangeOrderBuilder_AttachSignature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Order), args[1].([]byte), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_AttachSignature_Call) Return(_a0 *model.SignedOrder, _a1 error) *ExchangeOrderBuilder_AttachSignature_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_AttachSignature_Call) RunAndReturn(run func(*model.Order, []byte, int) (*model.SignedOrder, error)) *ExchangeOrderBuilder_AttachSignature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildMarketOrder provides a mock function with given fields: orderData, contract
+func (_m *ExchangeOrderBuilder) BuildMarketOrder(orderData *model.MarketOrderData, contract int) (*model.Order, error) {
+	ret := _m.Called(orderData, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildMarketOrder")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.MarketOrderData, int) (*model.Order, error)); ok {
+		return rf(orderData, contract)
+	}
+	if rf, ok := ret.Get(0).(func(*model.MarketOrderData, int) *model.Order); ok {
+		r0 = rf(orderData, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.MarketOrderData, int) error); ok {
+		r1 = rf(orderData, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_BuildMarketOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildMarketOrder'
+type ExchangeOrderBuilder_BuildMarketOrder_Call struct {
+	*mock.Call
+}
+
+// BuildMarketOrder is a helper method to define mock.On call
+//   - orderData *model.MarketOrderData
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildMarketOrder(orderData interface{}, contract interface{}) *ExchangeOrderBuilder_BuildMarketOrder_Call {
+	return &ExchangeOrderBuilder_BuildMarketOrder_Call{Call: _e.mock.On("BuildMarketOrder", orderData, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildMarketOrder_Call) Run(run func(orderData *model.MarketOrderData, contract int)) *ExchangeOrderBuilder_BuildMarketOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.MarketOrderData), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildMarketOrder_Call) Return(_a0 *model.Order, _a1 error) *ExchangeOrderBuilder_BuildMarketOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildMarketOrder_Call) RunAndReturn(run func(*model.MarketOrderData, int) (*model.Order, error)) *ExchangeOrderBuilder_BuildMarketOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BuildOrder provides a mock function with given fields: orderData
 func (_m *ExchangeOrderBuilder) BuildOrder(orderData *model.OrderData) (*model.Order, error) {
 	ret := _m.Called(orderData)
 
+	if len(ret) == 0 {
+		panic("no return value specified for BuildOrder")
+	}
+
 	var r0 *model.Order
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*model.OrderData) (*model.Order, error)); ok {
@@ -43,10 +179,102 @@ func (_m *ExchangeOrderBuilder) BuildOrder(orderData *model.OrderData) (*model.O
 	return r0, r1
 }
 
+// ExchangeOrderBuilder_BuildOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildOrder'
+type ExchangeOrderBuilder_BuildOrder_Call struct {
+	*mock.Call
+}
+
+// BuildOrder is a helper method to define mock.On call
+//   - orderData *model.OrderData
+func (_e *ExchangeOrderBuilder_Expecter) BuildOrder(orderData interface{}) *ExchangeOrderBuilder_BuildOrder_Call {
+	return &ExchangeOrderBuilder_BuildOrder_Call{Call: _e.mock.On("BuildOrder", orderData)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrder_Call) Run(run func(orderData *model.OrderData)) *ExchangeOrderBuilder_BuildOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.OrderData))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrder_Call) Return(_a0 *model.Order, _a1 error) *ExchangeOrderBuilder_BuildOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrder_Call) RunAndReturn(run func(*model.OrderData) (*model.Order, error)) *ExchangeOrderBuilder_BuildOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildOrderFromPrice provides a mock function with given fields: _a0, params, contract
+func (_m *ExchangeOrderBuilder) BuildOrderFromPrice(_a0 signer.Signer, params model.PriceOrderParams, contract int) (*model.SignedOrder, error) {
+	ret := _m.Called(_a0, params, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildOrderFromPrice")
+	}
+
+	var r0 *model.SignedOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(signer.Signer, model.PriceOrderParams, int) (*model.SignedOrder, error)); ok {
+		return rf(_a0, params, contract)
+	}
+	if rf, ok := ret.Get(0).(func(signer.Signer, model.PriceOrderParams, int) *model.SignedOrder); ok {
+		r0 = rf(_a0, params, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SignedOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(signer.Signer, model.PriceOrderParams, int) error); ok {
+		r1 = rf(_a0, params, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_BuildOrderFromPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildOrderFromPrice'
+type ExchangeOrderBuilder_BuildOrderFromPrice_Call struct {
+	*mock.Call
+}
+
+// BuildOrderFromPrice is a helper method to define mock.On call
+//   - _a0 signer.Signer
+//   - params model.PriceOrderParams
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildOrderFromPrice(_a0 interface{}, params interface{}, contract interface{}) *ExchangeOrderBuilder_BuildOrderFromPrice_Call {
+	return &ExchangeOrderBuilder_BuildOrderFromPrice_Call{Call: _e.mock.On("BuildOrderFromPrice", _a0, params, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderFromPrice_Call) Run(run func(_a0 signer.Signer, params model.PriceOrderParams, contract int)) *ExchangeOrderBuilder_BuildOrderFromPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(signer.Signer), args[1].(model.PriceOrderParams), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderFromPrice_Call) Return(_a0 *model.SignedOrder, _a1 error) *ExchangeOrderBuilder_BuildOrderFromPrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderFromPrice_Call) RunAndReturn(run func(signer.Signer, model.PriceOrderParams, int) (*model.SignedOrder, error)) *ExchangeOrderBuilder_BuildOrderFromPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BuildOrderHash provides a mock function with given fields: order, contract
 func (_m *ExchangeOrderBuilder) BuildOrderHash(order *model.Order, contract int) (common.Hash, error) {
 	ret := _m.Called(order, contract)
 
+	if len(ret) == 0 {
+		panic("no return value specified for BuildOrderHash")
+	}
+
 	var r0 common.Hash
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*model.Order, int) (common.Hash, error)); ok {
@@ -69,25 +297,295 @@ func (_m *ExchangeOrderBuilder) BuildOrderHash(order *model.Order, contract int)
 	return r0, r1
 }
 
-// BuildOrderSignature provides a mock function with given fields: privateKey, orderHash
-func (_m *ExchangeOrderBuilder) BuildOrderSignature(privateKey *ecdsa.PrivateKey, orderHash common.Hash) ([]byte, error) {
-	ret := _m.Called(privateKey, orderHash)
+// ExchangeOrderBuilder_BuildOrderHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildOrderHash'
+type ExchangeOrderBuilder_BuildOrderHash_Call struct {
+	*mock.Call
+}
+
+// BuildOrderHash is a helper method to define mock.On call
+//   - order *model.Order
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildOrderHash(order interface{}, contract interface{}) *ExchangeOrderBuilder_BuildOrderHash_Call {
+	return &ExchangeOrderBuilder_BuildOrderHash_Call{Call: _e.mock.On("BuildOrderHash", order, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderHash_Call) Run(run func(order *model.Order, contract int)) *ExchangeOrderBuilder_BuildOrderHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Order), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderHash_Call) Return(_a0 common.Hash, _a1 error) *ExchangeOrderBuilder_BuildOrderHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderHash_Call) RunAndReturn(run func(*model.Order, int) (common.Hash, error)) *ExchangeOrderBuilder_BuildOrderHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildOrderHashes provides a mock function with given fields: orders, contract
+func (_m *ExchangeOrderBuilder) BuildOrderHashes(orders []*model.Order, contract int) ([]common.Hash, error) {
+	ret := _m.Called(orders, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildOrderHashes")
+	}
+
+	var r0 []common.Hash
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]*model.Order, int) ([]common.Hash, error)); ok {
+		return rf(orders, contract)
+	}
+	if rf, ok := ret.Get(0).(func([]*model.Order, int) []common.Hash); ok {
+		r0 = rf(orders, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]*model.Order, int) error); ok {
+		r1 = rf(orders, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_BuildOrderHashes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildOrderHashes'
+type ExchangeOrderBuilder_BuildOrderHashes_Call struct {
+	*mock.Call
+}
+
+// BuildOrderHashes is a helper method to define mock.On call
+//   - orders []*model.Order
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildOrderHashes(orders interface{}, contract interface{}) *ExchangeOrderBuilder_BuildOrderHashes_Call {
+	return &ExchangeOrderBuilder_BuildOrderHashes_Call{Call: _e.mock.On("BuildOrderHashes", orders, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderHashes_Call) Run(run func(orders []*model.Order, contract int)) *ExchangeOrderBuilder_BuildOrderHashes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*model.Order), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderHashes_Call) Return(_a0 []common.Hash, _a1 error) *ExchangeOrderBuilder_BuildOrderHashes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderHashes_Call) RunAndReturn(run func([]*model.Order, int) ([]common.Hash, error)) *ExchangeOrderBuilder_BuildOrderHashes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildOrderSignature provides a mock function with given fields: _a0, order, contract
+func (_m *ExchangeOrderBuilder) BuildOrderSignature(_a0 signer.Signer, order *model.Order, contract int) ([]byte, error) {
+	ret := _m.Called(_a0, order, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildOrderSignature")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(signer.Signer, *model.Order, int) ([]byte, error)); ok {
+		return rf(_a0, order, contract)
+	}
+	if rf, ok := ret.Get(0).(func(signer.Signer, *model.Order, int) []byte); ok {
+		r0 = rf(_a0, order, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(signer.Signer, *model.Order, int) error); ok {
+		r1 = rf(_a0, order, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_BuildOrderSignature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildOrderSignature'
+type ExchangeOrderBuilder_BuildOrderSignature_Call struct {
+	*mock.Call
+}
+
+// BuildOrderSignature is a helper method to define mock.On call
+//   - _a0 signer.Signer
+//   - order *model.Order
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildOrderSignature(_a0 interface{}, order interface{}, contract interface{}) *ExchangeOrderBuilder_BuildOrderSignature_Call {
+	return &ExchangeOrderBuilder_BuildOrderSignature_Call{Call: _e.mock.On("BuildOrderSignature", _a0, order, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderSignature_Call) Run(run func(_a0 signer.Signer, order *model.Order, contract int)) *ExchangeOrderBuilder_BuildOrderSignature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(signer.Signer), args[1].(*model.Order), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderSignature_Call) Return(_a0 []byte, _a1 error) *ExchangeOrderBuilder_BuildOrderSignature_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderSignature_Call) RunAndReturn(run func(signer.Signer, *model.Order, int) ([]byte, error)) *ExchangeOrderBuilder_BuildOrderSignature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildOrderTypedData provides a mock function with given fields: order, contract
+func (_m *ExchangeOrderBuilder) BuildOrderTypedData(order *model.Order, contract int) (*apitypes.TypedData, error) {
+	ret := _m.Called(order, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildOrderTypedData")
+	}
+
+	var r0 *apitypes.TypedData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Order, int) (*apitypes.TypedData, error)); ok {
+		return rf(order, contract)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Order, int) *apitypes.TypedData); ok {
+		r0 = rf(order, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apitypes.TypedData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Order, int) error); ok {
+		r1 = rf(order, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_BuildOrderTypedData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildOrderTypedData'
+type ExchangeOrderBuilder_BuildOrderTypedData_Call struct {
+	*mock.Call
+}
+
+// BuildOrderTypedData is a helper method to define mock.On call
+//   - order *model.Order
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildOrderTypedData(order interface{}, contract interface{}) *ExchangeOrderBuilder_BuildOrderTypedData_Call {
+	return &ExchangeOrderBuilder_BuildOrderTypedData_Call{Call: _e.mock.On("BuildOrderTypedData", order, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderTypedData_Call) Run(run func(order *model.Order, contract int)) *ExchangeOrderBuilder_BuildOrderTypedData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Order), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderTypedData_Call) Return(_a0 *apitypes.TypedData, _a1 error) *ExchangeOrderBuilder_BuildOrderTypedData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderTypedData_Call) RunAndReturn(run func(*model.Order, int) (*apitypes.TypedData, error)) *ExchangeOrderBuilder_BuildOrderTypedData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildOrderTypedDataJSON provides a mock function with given fields: order, contract
+func (_m *ExchangeOrderBuilder) BuildOrderTypedDataJSON(order *model.Order, contract int) ([]byte, error) {
+	ret := _m.Called(order, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildOrderTypedDataJSON")
+	}
 
 	var r0 []byte
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*ecdsa.PrivateKey, common.Hash) ([]byte, error)); ok {
-		return rf(privateKey, orderHash)
+	if rf, ok := ret.Get(0).(func(*model.Order, int) ([]byte, error)); ok {
+		return rf(order, contract)
 	}
-	if rf, ok := ret.Get(0).(func(*ecdsa.PrivateKey, common.Hash) []byte); ok {
-		r0 = rf(privateKey, orderHash)
+	if rf, ok := ret.Get(0).(func(*model.Order, int) []byte); ok {
+		r0 = rf(order, contract)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*ecdsa.PrivateKey, common.Hash) error); ok {
-		r1 = rf(privateKey, orderHash)
+	if rf, ok := ret.Get(1).(func(*model.Order, int) error); ok {
+		r1 = rf(order, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_BuildOrderTypedDataJSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildOrderTypedDataJSON'
+type ExchangeOrderBuilder_BuildOrderTypedDataJSON_Call struct {
+	*mock.Call
+}
+
+// BuildOrderTypedDataJSON is a helper method to define mock.On call
+//   - order *model.Order
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildOrderTypedDataJSON(order interface{}, contract interface{}) *ExchangeOrderBuilder_BuildOrderTypedDataJSON_Call {
+	return &ExchangeOrderBuilder_BuildOrderTypedDataJSON_Call{Call: _e.mock.On("BuildOrderTypedDataJSON", order, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderTypedDataJSON_Call) Run(run func(order *model.Order, contract int)) *ExchangeOrderBuilder_BuildOrderTypedDataJSON_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Order), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderTypedDataJSON_Call) Return(_a0 []byte, _a1 error) *ExchangeOrderBuilder_BuildOrderTypedDataJSON_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildOrderTypedDataJSON_Call) RunAndReturn(run func(*model.Order, int) ([]byte, error)) *ExchangeOrderBuilder_BuildOrderTypedDataJSON_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildSignedOrder provides a mock function with given fields: _a0, orderData, contract
+func (_m *ExchangeOrderBuilder) BuildSignedOrder(_a0 signer.Signer, orderData *model.OrderData, contract int) (*model.SignedOrder, error) {
+	ret := _m.Called(_a0, orderData, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildSignedOrder")
+	}
+
+	var r0 *model.SignedOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(signer.Signer, *model.OrderData, int) (*model.SignedOrder, error)); ok {
+		return rf(_a0, orderData, contract)
+	}
+	if rf, ok := ret.Get(0).(func(signer.Signer, *model.OrderData, int) *model.SignedOrder); ok {
+		r0 = rf(_a0, orderData, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SignedOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(signer.Signer, *model.OrderData, int) error); ok {
+		r1 = rf(_a0, orderData, contract)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -95,25 +593,59 @@ func (_m *ExchangeOrderBuilder) BuildOrderSignature(privateKey *ecdsa.PrivateKey
 	return r0, r1
 }
 
-// BuildSignedOrder provides a mock function with given fields: privateKey, orderData, contract
-func (_m *ExchangeOrderBuilder) BuildSignedOrder(privateKey *ecdsa.PrivateKey, orderData *model.OrderData, contract int) (*model.SignedOrder, error) {
-	ret := _m.Called(privateKey, orderData, contract)
+// ExchangeOrderBuilder_BuildSignedOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildSignedOrder'
+type ExchangeOrderBuilder_BuildSignedOrder_Call struct {
+	*mock.Call
+}
+
+// BuildSignedOrder is a helper method to define mock.On call
+//   - _a0 signer.Signer
+//   - orderData *model.OrderData
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildSignedOrder(_a0 interface{}, orderData interface{}, contract interface{}) *ExchangeOrderBuilder_BuildSignedOrder_Call {
+	return &ExchangeOrderBuilder_BuildSignedOrder_Call{Call: _e.mock.On("BuildSignedOrder", _a0, orderData, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrder_Call) Run(run func(_a0 signer.Signer, orderData *model.OrderData, contract int)) *ExchangeOrderBuilder_BuildSignedOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(signer.Signer), args[1].(*model.OrderData), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrder_Call) Return(_a0 *model.SignedOrder, _a1 error) *ExchangeOrderBuilder_BuildSignedOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrder_Call) RunAndReturn(run func(signer.Signer, *model.OrderData, int) (*model.SignedOrder, error)) *ExchangeOrderBuilder_BuildSignedOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildSignedOrderContext provides a mock function with given fields: ctx, _a1, orderData, contract
+func (_m *ExchangeOrderBuilder) BuildSignedOrderContext(ctx context.Context, _a1 signer.Signer, orderData *model.OrderData, contract int) (*model.SignedOrder, error) {
+	ret := _m.Called(ctx, _a1, orderData, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildSignedOrderContext")
+	}
 
 	var r0 *model.SignedOrder
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*ecdsa.PrivateKey, *model.OrderData, int) (*model.SignedOrder, error)); ok {
-		return rf(privateKey, orderData, contract)
+	if rf, ok := ret.Get(0).(func(context.Context, signer.Signer, *model.OrderData, int) (*model.SignedOrder, error)); ok {
+		return rf(ctx, _a1, orderData, contract)
 	}
-	if rf, ok := ret.Get(0).(func(*ecdsa.PrivateKey, *model.OrderData, int) *model.SignedOrder); ok {
-		r0 = rf(privateKey, orderData, contract)
+	if rf, ok := ret.Get(0).(func(context.Context, signer.Signer, *model.OrderData, int) *model.SignedOrder); ok {
+		r0 = rf(ctx, _a1, orderData, contract)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.SignedOrder)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*ecdsa.PrivateKey, *model.OrderData, int) error); ok {
-		r1 = rf(privateKey, orderData, contract)
+	if rf, ok := ret.Get(1).(func(context.Context, signer.Signer, *model.OrderData, int) error); ok {
+		r1 = rf(ctx, _a1, orderData, contract)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -121,6 +653,682 @@ func (_m *ExchangeOrderBuilder) BuildSignedOrder(privateKey *ecdsa.PrivateKey, o
 	return r0, r1
 }
 
+// ExchangeOrderBuilder_BuildSignedOrderContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildSignedOrderContext'
+type ExchangeOrderBuilder_BuildSignedOrderContext_Call struct {
+	*mock.Call
+}
+
+// BuildSignedOrderContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 signer.Signer
+//   - orderData *model.OrderData
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildSignedOrderContext(ctx interface{}, _a1 interface{}, orderData interface{}, contract interface{}) *ExchangeOrderBuilder_BuildSignedOrderContext_Call {
+	return &ExchangeOrderBuilder_BuildSignedOrderContext_Call{Call: _e.mock.On("BuildSignedOrderContext", ctx, _a1, orderData, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrderContext_Call) Run(run func(ctx context.Context, _a1 signer.Signer, orderData *model.OrderData, contract int)) *ExchangeOrderBuilder_BuildSignedOrderContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(signer.Signer), args[2].(*model.OrderData), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrderContext_Call) Return(_a0 *model.SignedOrder, _a1 error) *ExchangeOrderBuilder_BuildSignedOrderContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrderContext_Call) RunAndReturn(run func(context.Context, signer.Signer, *model.OrderData, int) (*model.SignedOrder, error)) *ExchangeOrderBuilder_BuildSignedOrderContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildSignedOrderWithHash provides a mock function with given fields: _a0, orderData, contract
+func (_m *ExchangeOrderBuilder) BuildSignedOrderWithHash(_a0 signer.Signer, orderData *model.OrderData, contract int) (*model.SignedOrder, common.Hash, error) {
+	ret := _m.Called(_a0, orderData, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildSignedOrderWithHash")
+	}
+
+	var r0 *model.SignedOrder
+	var r1 common.Hash
+	var r2 error
+	if rf, ok := ret.Get(0).(func(signer.Signer, *model.OrderData, int) (*model.SignedOrder, common.Hash, error)); ok {
+		return rf(_a0, orderData, contract)
+	}
+	if rf, ok := ret.Get(0).(func(signer.Signer, *model.OrderData, int) *model.SignedOrder); ok {
+		r0 = rf(_a0, orderData, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SignedOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(signer.Signer, *model.OrderData, int) common.Hash); ok {
+		r1 = rf(_a0, orderData, contract)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(signer.Signer, *model.OrderData, int) error); ok {
+		r2 = rf(_a0, orderData, contract)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ExchangeOrderBuilder_BuildSignedOrderWithHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildSignedOrderWithHash'
+type ExchangeOrderBuilder_BuildSignedOrderWithHash_Call struct {
+	*mock.Call
+}
+
+// BuildSignedOrderWithHash is a helper method to define mock.On call
+//   - _a0 signer.Signer
+//   - orderData *model.OrderData
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildSignedOrderWithHash(_a0 interface{}, orderData interface{}, contract interface{}) *ExchangeOrderBuilder_BuildSignedOrderWithHash_Call {
+	return &ExchangeOrderBuilder_BuildSignedOrderWithHash_Call{Call: _e.mock.On("BuildSignedOrderWithHash", _a0, orderData, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrderWithHash_Call) Run(run func(_a0 signer.Signer, orderData *model.OrderData, contract int)) *ExchangeOrderBuilder_BuildSignedOrderWithHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(signer.Signer), args[1].(*model.OrderData), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrderWithHash_Call) Return(_a0 *model.SignedOrder, _a1 common.Hash, _a2 error) *ExchangeOrderBuilder_BuildSignedOrderWithHash_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrderWithHash_Call) RunAndReturn(run func(signer.Signer, *model.OrderData, int) (*model.SignedOrder, common.Hash, error)) *ExchangeOrderBuilder_BuildSignedOrderWithHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildSignedOrders provides a mock function with given fields: _a0, orders, contract
+func (_m *ExchangeOrderBuilder) BuildSignedOrders(_a0 signer.Signer, orders []*model.OrderData, contract int) ([]*model.SignedOrder, error) {
+	ret := _m.Called(_a0, orders, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildSignedOrders")
+	}
+
+	var r0 []*model.SignedOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(signer.Signer, []*model.OrderData, int) ([]*model.SignedOrder, error)); ok {
+		return rf(_a0, orders, contract)
+	}
+	if rf, ok := ret.Get(0).(func(signer.Signer, []*model.OrderData, int) []*model.SignedOrder); ok {
+		r0 = rf(_a0, orders, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SignedOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(signer.Signer, []*model.OrderData, int) error); ok {
+		r1 = rf(_a0, orders, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_BuildSignedOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildSignedOrders'
+type ExchangeOrderBuilder_BuildSignedOrders_Call struct {
+	*mock.Call
+}
+
+// BuildSignedOrders is a helper method to define mock.On call
+//   - _a0 signer.Signer
+//   - orders []*model.OrderData
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) BuildSignedOrders(_a0 interface{}, orders interface{}, contract interface{}) *ExchangeOrderBuilder_BuildSignedOrders_Call {
+	return &ExchangeOrderBuilder_BuildSignedOrders_Call{Call: _e.mock.On("BuildSignedOrders", _a0, orders, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrders_Call) Run(run func(_a0 signer.Signer, orders []*model.OrderData, contract int)) *ExchangeOrderBuilder_BuildSignedOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(signer.Signer), args[1].([]*model.OrderData), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrders_Call) Return(_a0 []*model.SignedOrder, _a1 error) *ExchangeOrderBuilder_BuildSignedOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrders_Call) RunAndReturn(run func(signer.Signer, []*model.OrderData, int) ([]*model.SignedOrder, error)) *ExchangeOrderBuilder_BuildSignedOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildSignedOrdersMulti provides a mock function with given fields: _a0, items
+func (_m *ExchangeOrderBuilder) BuildSignedOrdersMulti(_a0 signer.Signer, items []builder.OrderBatchItem) ([]*model.SignedOrder, error) {
+	ret := _m.Called(_a0, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildSignedOrdersMulti")
+	}
+
+	var r0 []*model.SignedOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(signer.Signer, []builder.OrderBatchItem) ([]*model.SignedOrder, error)); ok {
+		return rf(_a0, items)
+	}
+	if rf, ok := ret.Get(0).(func(signer.Signer, []builder.OrderBatchItem) []*model.SignedOrder); ok {
+		r0 = rf(_a0, items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SignedOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(signer.Signer, []builder.OrderBatchItem) error); ok {
+		r1 = rf(_a0, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_BuildSignedOrdersMulti_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildSignedOrdersMulti'
+type ExchangeOrderBuilder_BuildSignedOrdersMulti_Call struct {
+	*mock.Call
+}
+
+// BuildSignedOrdersMulti is a helper method to define mock.On call
+//   - _a0 signer.Signer
+//   - items []builder.OrderBatchItem
+func (_e *ExchangeOrderBuilder_Expecter) BuildSignedOrdersMulti(_a0 interface{}, items interface{}) *ExchangeOrderBuilder_BuildSignedOrdersMulti_Call {
+	return &ExchangeOrderBuilder_BuildSignedOrdersMulti_Call{Call: _e.mock.On("BuildSignedOrdersMulti", _a0, items)}
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrdersMulti_Call) Run(run func(_a0 signer.Signer, items []builder.OrderBatchItem)) *ExchangeOrderBuilder_BuildSignedOrdersMulti_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(signer.Signer), args[1].([]builder.OrderBatchItem))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrdersMulti_Call) Return(_a0 []*model.SignedOrder, _a1 error) *ExchangeOrderBuilder_BuildSignedOrdersMulti_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_BuildSignedOrdersMulti_Call) RunAndReturn(run func(signer.Signer, []builder.OrderBatchItem) ([]*model.SignedOrder, error)) *ExchangeOrderBuilder_BuildSignedOrdersMulti_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DomainSeparator provides a mock function with given fields: contract
+func (_m *ExchangeOrderBuilder) DomainSeparator(contract int) (common.Hash, error) {
+	ret := _m.Called(contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DomainSeparator")
+	}
+
+	var r0 common.Hash
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (common.Hash, error)); ok {
+		return rf(contract)
+	}
+	if rf, ok := ret.Get(0).(func(int) common.Hash); ok {
+		r0 = rf(contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_DomainSeparator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DomainSeparator'
+type ExchangeOrderBuilder_DomainSeparator_Call struct {
+	*mock.Call
+}
+
+// DomainSeparator is a helper method to define mock.On call
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) DomainSeparator(contract interface{}) *ExchangeOrderBuilder_DomainSeparator_Call {
+	return &ExchangeOrderBuilder_DomainSeparator_Call{Call: _e.mock.On("DomainSeparator", contract)}
+}
+
+func (_c *ExchangeOrderBuilder_DomainSeparator_Call) Run(run func(contract int)) *ExchangeOrderBuilder_DomainSeparator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_DomainSeparator_Call) Return(_a0 common.Hash, _a1 error) *ExchangeOrderBuilder_DomainSeparator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_DomainSeparator_Call) RunAndReturn(run func(int) (common.Hash, error)) *ExchangeOrderBuilder_DomainSeparator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Explain provides a mock function with given fields: orderData, contract
+func (_m *ExchangeOrderBuilder) Explain(orderData *model.OrderData, contract int) (*model.OrderExplanation, error) {
+	ret := _m.Called(orderData, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Explain")
+	}
+
+	var r0 *model.OrderExplanation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.OrderData, int) (*model.OrderExplanation, error)); ok {
+		return rf(orderData, contract)
+	}
+	if rf, ok := ret.Get(0).(func(*model.OrderData, int) *model.OrderExplanation); ok {
+		r0 = rf(orderData, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderExplanation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.OrderData, int) error); ok {
+		r1 = rf(orderData, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_Explain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Explain'
+type ExchangeOrderBuilder_Explain_Call struct {
+	*mock.Call
+}
+
+// Explain is a helper method to define mock.On call
+//   - orderData *model.OrderData
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) Explain(orderData interface{}, contract interface{}) *ExchangeOrderBuilder_Explain_Call {
+	return &ExchangeOrderBuilder_Explain_Call{Call: _e.mock.On("Explain", orderData, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_Explain_Call) Run(run func(orderData *model.OrderData, contract int)) *ExchangeOrderBuilder_Explain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.OrderData), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_Explain_Call) Return(_a0 *model.OrderExplanation, _a1 error) *ExchangeOrderBuilder_Explain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_Explain_Call) RunAndReturn(run func(*model.OrderData, int) (*model.OrderExplanation, error)) *ExchangeOrderBuilder_Explain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrderID provides a mock function with given fields: orderData, contract
+func (_m *ExchangeOrderBuilder) OrderID(orderData *model.OrderData, contract int) (common.Hash, error) {
+	ret := _m.Called(orderData, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderID")
+	}
+
+	var r0 common.Hash
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.OrderData, int) (common.Hash, error)); ok {
+		return rf(orderData, contract)
+	}
+	if rf, ok := ret.Get(0).(func(*model.OrderData, int) common.Hash); ok {
+		r0 = rf(orderData, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.OrderData, int) error); ok {
+		r1 = rf(orderData, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_OrderID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrderID'
+type ExchangeOrderBuilder_OrderID_Call struct {
+	*mock.Call
+}
+
+// OrderID is a helper method to define mock.On call
+//   - orderData *model.OrderData
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) OrderID(orderData interface{}, contract interface{}) *ExchangeOrderBuilder_OrderID_Call {
+	return &ExchangeOrderBuilder_OrderID_Call{Call: _e.mock.On("OrderID", orderData, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_OrderID_Call) Run(run func(orderData *model.OrderData, contract int)) *ExchangeOrderBuilder_OrderID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.OrderData), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_OrderID_Call) Return(_a0 common.Hash, _a1 error) *ExchangeOrderBuilder_OrderID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_OrderID_Call) RunAndReturn(run func(*model.OrderData, int) (common.Hash, error)) *ExchangeOrderBuilder_OrderID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrderStructHash provides a mock function with given fields: order
+func (_m *ExchangeOrderBuilder) OrderStructHash(order *model.Order) (common.Hash, error) {
+	ret := _m.Called(order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderStructHash")
+	}
+
+	var r0 common.Hash
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Order) (common.Hash, error)); ok {
+		return rf(order)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Order) common.Hash); ok {
+		r0 = rf(order)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Order) error); ok {
+		r1 = rf(order)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_OrderStructHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrderStructHash'
+type ExchangeOrderBuilder_OrderStructHash_Call struct {
+	*mock.Call
+}
+
+// OrderStructHash is a helper method to define mock.On call
+//   - order *model.Order
+func (_e *ExchangeOrderBuilder_Expecter) OrderStructHash(order interface{}) *ExchangeOrderBuilder_OrderStructHash_Call {
+	return &ExchangeOrderBuilder_OrderStructHash_Call{Call: _e.mock.On("OrderStructHash", order)}
+}
+
+func (_c *ExchangeOrderBuilder_OrderStructHash_Call) Run(run func(order *model.Order)) *ExchangeOrderBuilder_OrderStructHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Order))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_OrderStructHash_Call) Return(_a0 common.Hash, _a1 error) *ExchangeOrderBuilder_OrderStructHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_OrderStructHash_Call) RunAndReturn(run func(*model.Order) (common.Hash, error)) *ExchangeOrderBuilder_OrderStructHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecoverOrderSigner provides a mock function with given fields: order, signature, contract
+func (_m *ExchangeOrderBuilder) RecoverOrderSigner(order *model.Order, signature []byte, contract int) (common.Address, error) {
+	ret := _m.Called(order, signature, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoverOrderSigner")
+	}
+
+	var r0 common.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Order, []byte, int) (common.Address, error)); ok {
+		return rf(order, signature, contract)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Order, []byte, int) common.Address); ok {
+		r0 = rf(order, signature, contract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Order, []byte, int) error); ok {
+		r1 = rf(order, signature, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_RecoverOrderSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoverOrderSigner'
+type ExchangeOrderBuilder_RecoverOrderSigner_Call struct {
+	*mock.Call
+}
+
+// RecoverOrderSigner is a helper method to define mock.On call
+//   - order *model.Order
+//   - signature []byte
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) RecoverOrderSigner(order interface{}, signature interface{}, contract interface{}) *ExchangeOrderBuilder_RecoverOrderSigner_Call {
+	return &ExchangeOrderBuilder_RecoverOrderSigner_Call{Call: _e.mock.On("RecoverOrderSigner", order, signature, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_RecoverOrderSigner_Call) Run(run func(order *model.Order, signature []byte, contract int)) *ExchangeOrderBuilder_RecoverOrderSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Order), args[1].([]byte), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_RecoverOrderSigner_Call) Return(_a0 common.Address, _a1 error) *ExchangeOrderBuilder_RecoverOrderSigner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_RecoverOrderSigner_Call) RunAndReturn(run func(*model.Order, []byte, int) (common.Address, error)) *ExchangeOrderBuilder_RecoverOrderSigner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SameHash provides a mock function with given fields: a, b, contract
+func (_m *ExchangeOrderBuilder) SameHash(a *model.Order, b *model.Order, contract int) (bool, error) {
+	ret := _m.Called(a, b, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SameHash")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Order, *model.Order, int) (bool, error)); ok {
+		return rf(a, b, contract)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Order, *model.Order, int) bool); ok {
+		r0 = rf(a, b, contract)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Order, *model.Order, int) error); ok {
+		r1 = rf(a, b, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_SameHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SameHash'
+type ExchangeOrderBuilder_SameHash_Call struct {
+	*mock.Call
+}
+
+// SameHash is a helper method to define mock.On call
+//   - a *model.Order
+//   - b *model.Order
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) SameHash(a interface{}, b interface{}, contract interface{}) *ExchangeOrderBuilder_SameHash_Call {
+	return &ExchangeOrderBuilder_SameHash_Call{Call: _e.mock.On("SameHash", a, b, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_SameHash_Call) Run(run func(a *model.Order, b *model.Order, contract int)) *ExchangeOrderBuilder_SameHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Order), args[1].(*model.Order), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_SameHash_Call) Return(_a0 bool, _a1 error) *ExchangeOrderBuilder_SameHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_SameHash_Call) RunAndReturn(run func(*model.Order, *model.Order, int) (bool, error)) *ExchangeOrderBuilder_SameHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyOrderMatches provides a mock function with given fields: order, expected, contract
+func (_m *ExchangeOrderBuilder) VerifyOrderMatches(order *model.SignedOrder, expected model.OrderExpectation, contract int) error {
+	ret := _m.Called(order, expected, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyOrderMatches")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.SignedOrder, model.OrderExpectation, int) error); ok {
+		r0 = rf(order, expected, contract)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExchangeOrderBuilder_VerifyOrderMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyOrderMatches'
+type ExchangeOrderBuilder_VerifyOrderMatches_Call struct {
+	*mock.Call
+}
+
+// VerifyOrderMatches is a helper method to define mock.On call
+//   - order *model.SignedOrder
+//   - expected model.OrderExpectation
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) VerifyOrderMatches(order interface{}, expected interface{}, contract interface{}) *ExchangeOrderBuilder_VerifyOrderMatches_Call {
+	return &ExchangeOrderBuilder_VerifyOrderMatches_Call{Call: _e.mock.On("VerifyOrderMatches", order, expected, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_VerifyOrderMatches_Call) Run(run func(order *model.SignedOrder, expected model.OrderExpectation, contract int)) *ExchangeOrderBuilder_VerifyOrderMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.SignedOrder), args[1].(model.OrderExpectation), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_VerifyOrderMatches_Call) Return(_a0 error) *ExchangeOrderBuilder_VerifyOrderMatches_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_VerifyOrderMatches_Call) RunAndReturn(run func(*model.SignedOrder, model.OrderExpectation, int) error) *ExchangeOrderBuilder_VerifyOrderMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifySignedOrder provides a mock function with given fields: order, contract
+func (_m *ExchangeOrderBuilder) VerifySignedOrder(order *model.SignedOrder, contract int) (bool, error) {
+	ret := _m.Called(order, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifySignedOrder")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.SignedOrder, int) (bool, error)); ok {
+		return rf(order, contract)
+	}
+	if rf, ok := ret.Get(0).(func(*model.SignedOrder, int) bool); ok {
+		r0 = rf(order, contract)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.SignedOrder, int) error); ok {
+		r1 = rf(order, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExchangeOrderBuilder_VerifySignedOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifySignedOrder'
+type ExchangeOrderBuilder_VerifySignedOrder_Call struct {
+	*mock.Call
+}
+
+// VerifySignedOrder is a helper method to define mock.On call
+//   - order *model.SignedOrder
+//   - contract int
+func (_e *ExchangeOrderBuilder_Expecter) VerifySignedOrder(order interface{}, contract interface{}) *ExchangeOrderBuilder_VerifySignedOrder_Call {
+	return &ExchangeOrderBuilder_VerifySignedOrder_Call{Call: _e.mock.On("VerifySignedOrder", order, contract)}
+}
+
+func (_c *ExchangeOrderBuilder_VerifySignedOrder_Call) Run(run func(order *model.SignedOrder, contract int)) *ExchangeOrderBuilder_VerifySignedOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.SignedOrder), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_VerifySignedOrder_Call) Return(_a0 bool, _a1 error) *ExchangeOrderBuilder_VerifySignedOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExchangeOrderBuilder_VerifySignedOrder_Call) RunAndReturn(run func(*model.SignedOrder, int) (bool, error)) *ExchangeOrderBuilder_VerifySignedOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewExchangeOrderBuilder creates a new instance of ExchangeOrderBuilder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewExchangeOrderBuilder(t interface {
@@ -0,0 +1,55 @@
+package mocks_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/builder"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/builder/mocks"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/signer"
+	signermocks "github.com/ivanzzeth/polymarket-go-order-utils/pkg/signer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	_ builder.ExchangeOrderBuilder = (*mocks.ExchangeOrderBuilder)(nil)
+	_ signer.Signer                = (*signermocks.Signer)(nil)
+)
+
+// placeOrder stands for the code under test, it only depends on the interfaces
+func placeOrder(b builder.ExchangeOrderBuilder, s signer.Signer, orderData *model.OrderData) (model.OrderHash, error) {
+	signedOrder, err := b.BuildSignedOrder(s, orderData, model.CTFExchange)
+	if err != nil {
+		return model.OrderHash{}, err
+	}
+	return signedOrder.OrderHash, nil
+}
+
+func TestExpecter(t *testing.T) {
+	// the expectations are asserted when the test ends
+	orderBuilder := mocks.NewExchangeOrderBuilder(t)
+	orderSigner := signermocks.NewSigner(t)
+
+	orderData := &model.OrderData{
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+	}
+	orderHash := common.HexToHash("0x5306d37dc6f0e4ce2bd6bb9bc5c6a3e1c5e3d1a1b2c3d4e5f60718293a4b504b")
+
+	signerAddress := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	orderSigner.EXPECT().GetAddress().Return(signerAddress).Once()
+	orderBuilder.EXPECT().
+		BuildSignedOrder(orderSigner, orderData, mock.Anything).
+		RunAndReturn(func(s signer.Signer, _ *model.OrderData, _ model.VerifyingContract) (*model.SignedOrder, error) {
+			return &model.SignedOrder{Order: model.Order{Signer: s.GetAddress()}, OrderHash: orderHash}, nil
+		}).
+		Once()
+
+	hash, err := placeOrder(orderBuilder, orderSigner, orderData)
+	assert.NoError(t, err)
+	assert.Equal(t, orderHash, hash)
+}
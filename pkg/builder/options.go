@@ -0,0 +1,124 @@
+package builder
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/utils"
+	"github.com/shopspring/decimal"
+)
+
+// SaltGenerator generates the salt of each built order.
+//
+// Two orders with the same salt and the same fields produce the same hash,
+// so a deterministic generator keyed off the order parameters can be used to make
+// order submission idempotent: a retried submit signs the exact same order.
+type SaltGenerator func() *big.Int
+
+// Option configures an ExchangeOrderBuilderImpl
+type Option func(*ExchangeOrderBuilderImpl)
+
+// WithSaltGenerator overrides the salt generator of the builder.
+// The default generator draws random salts from crypto/rand in the range of the official clients,
+// see utils.GenerateRandomSalt.
+// The generator is called concurrently when the builder is shared between goroutines.
+func WithSaltGenerator(saltGenerator SaltGenerator) Option {
+	return func(e *ExchangeOrderBuilderImpl) {
+		if saltGenerator != nil {
+			e.saltGenerator = saltGenerator
+		}
+	}
+}
+
+// WithSaltRange draws the random salts uniformly in [1, max] instead of the default range
+// of Polymarket's official clients, [1, the current unix time in seconds].
+// A max below 1 always draws 1.
+func WithSaltRange(max int64) Option {
+	return WithSaltGenerator(func() *big.Int {
+		return big.NewInt(utils.GenerateRandomSaltUpTo(max))
+	})
+}
+
+// WithTickSize enforces the tick size of the market.
+// BuildOrder and BuildMarketOrder return model.ErrPriceNotOnTick
+// when the order price is not a multiple of the tick size.
+func WithTickSize(tickSize decimal.Decimal) Option {
+	return func(e *ExchangeOrderBuilderImpl) {
+		e.tickSize = tickSize
+	}
+}
+
+// WithMaxFeeRateBps caps the fee rate of the orders at the max fee of the market, in basis points.
+// BuildOrder and BuildMarketOrder return model.ErrFeeTooHigh above the cap.
+// It overrides the cap of the chain config, model.MaxFeeRateBps by default.
+func WithMaxFeeRateBps(maxFeeRateBps int64) Option {
+	return func(e *ExchangeOrderBuilderImpl) {
+		e.maxFeeRateBps = &maxFeeRateBps
+	}
+}
+
+// WithChainConfig overrides the chain config of the builder,
+// i.e the chain id and the verifying contract addresses of the EIP712 domain.
+// Use model.MainnetChainConfig or model.AmoyChainConfig for the built-in configs.
+func WithChainConfig(chainConfig *model.ChainConfig) Option {
+	return func(e *ExchangeOrderBuilderImpl) {
+		if chainConfig != nil {
+			c := *chainConfig
+			e.chainConfig = &c
+		}
+	}
+}
+
+// WithClock overrides the clock of the builder, the default clock is time.Now.
+// Orders with a nonzero expiration before the current time are rejected with model.ErrExpired.
+func WithClock(clock Clock) Option {
+	return func(e *ExchangeOrderBuilderImpl) {
+		if clock != nil {
+			e.clock = clock
+		}
+	}
+}
+
+// ContractSignaturePacker encodes the signature of a POLY_1271 order into the bytes
+// the wallet expects in its EIP-1271 isValidSignature(orderHash, signature).
+type ContractSignaturePacker func(orderHash common.Hash, signature []byte) ([]byte, error)
+
+// WithContractSignaturePacker sets how the signature of POLY_1271 orders is encoded.
+// By default the signature returned by the signer is used as is, which fits wallets
+// validating an ECDSA signature of their owner over the order hash.
+func WithContractSignaturePacker(packer ContractSignaturePacker) Option {
+	return func(e *ExchangeOrderBuilderImpl) {
+		e.contractSignaturePacker = packer
+	}
+}
+
+// OrderBuiltHook is called with each order built for an exchange and its hash,
+// e.g to keep an audit trail of the orders and signers of a service.
+type OrderBuiltHook func(order *model.Order, hash model.OrderHash)
+
+// WithOrderBuiltHook sets a hook fired after each successful build by BuildSignedOrder,
+// BuildSignedOrders, BuildMarketOrder and BuildOrder. BuildOrder doesn't know the exchange,
+// so it hashes the order for the CTF exchange. Read-only queries such as OrderID and Explain
+// don't fire the hook. A nil hook is a no-op.
+// The hook is called concurrently by BuildSignedOrders and must not modify the order.
+func WithOrderBuiltHook(hook OrderBuiltHook) Option {
+	return func(e *ExchangeOrderBuilderImpl) {
+		e.orderBuiltHook = hook
+	}
+}
+
+// WithSignatureCache memoizes the signatures of the built orders by signer address and order hash
+// for ttl, so building the exact same order again, salt included, doesn't call the signer,
+// e.g in a retry loop with a deterministic salt generator. Expiry follows the builder's Clock.
+// A non positive ttl disables the cache.
+func WithSignatureCache(ttl time.Duration) Option {
+	return func(e *ExchangeOrderBuilderImpl) {
+		if ttl <= 0 {
+			e.signatureCache = nil
+			return
+		}
+		e.signatureCache = newSignatureCache(ttl)
+	}
+}
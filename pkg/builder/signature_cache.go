@@ -0,0 +1,78 @@
+package builder
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+)
+
+// signatureCacheKey scopes a cached signature to the signer, two signers never share a signature
+type signatureCacheKey struct {
+	signer    common.Address
+	orderHash model.OrderHash
+}
+
+type signatureCacheEntry struct {
+	signature []byte
+	expires   time.Time
+}
+
+// signatureCacheSweepInterval is the number of inserts between two sweeps of the expired entries
+const signatureCacheSweepInterval = 256
+
+// signatureCache memoizes the signatures of the signers by order hash for a ttl.
+// Expired entries are dropped when looked up, and every signatureCacheSweepInterval inserts.
+// It is safe for concurrent use.
+type signatureCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[signatureCacheKey]signatureCacheEntry
+	puts    int
+}
+
+func newSignatureCache(ttl time.Duration) *signatureCache {
+	return &signatureCache{
+		ttl:     ttl,
+		entries: make(map[signatureCacheKey]signatureCacheEntry),
+	}
+}
+
+// get returns a copy of the signature of the order by the signer, if cached and not expired at now
+func (c *signatureCache) get(signer common.Address, orderHash model.OrderHash, now time.Time) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := signatureCacheKey{signer: signer, orderHash: orderHash}
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !now.Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return common.CopyBytes(entry.signature), true
+}
+
+// put caches the signature of the order by the signer until now + ttl,
+// sweeping the expired entries every signatureCacheSweepInterval inserts
+func (c *signatureCache) put(signer common.Address, orderHash model.OrderHash, signature []byte, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.puts++
+	if c.puts%signatureCacheSweepInterval == 0 {
+		for key, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, key)
+			}
+		}
+	}
+	c.entries[signatureCacheKey{signer: signer, orderHash: orderHash}] = signatureCacheEntry{
+		signature: common.CopyBytes(signature),
+		expires:   now.Add(c.ttl),
+	}
+}
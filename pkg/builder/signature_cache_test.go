@@ -0,0 +1,136 @@
+package builder
+
+import (
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/signer"
+	"github.com/stretchr/testify/assert"
+)
+
+type manualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *manualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// countingSigner signs with the private key for the address and counts the signatures
+func countingSigner(address common.Address, calls *atomic.Int32) *signer.FuncSigner {
+	return signer.NewFuncSigner(address, func(hash []byte) ([]byte, error) {
+		calls.Add(1)
+		return crypto.Sign(hash, privateKey)
+	})
+}
+
+func TestWithSignatureCache(t *testing.T) {
+	clock := &manualClock{now: time.Unix(1700000000, 0)}
+	builder := NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt },
+		WithClock(clock), WithSignatureCache(time.Minute))
+
+	orderData := &model.OrderData{
+		Maker:         "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC",
+		Signer:        signerAddress.Hex(),
+		TokenId:       "1234",
+		MakerAmount:   "50000000",
+		TakerAmount:   "100000000",
+		Side:          model.BUY,
+		FeeRateBps:    "100",
+		Nonce:         "0",
+		SignatureType: model.SignatureTypePolyProxy,
+	}
+
+	var calls atomic.Int32
+	s := countingSigner(signerAddress, &calls)
+
+	first, err := builder.BuildSignedOrder(s, orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// the same order is served from the cache
+	clock.Advance(59 * time.Second)
+	second, err := builder.BuildSignedOrder(s, orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, first, second)
+
+	// the returned signature doesn't alias the cached one
+	second.Signature[0] ^= 0xff
+	third, err := builder.BuildSignedOrder(s, orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, first.Signature, third.Signature)
+
+	// another order is signed
+	other := orderData.Clone()
+	other.Nonce = "1"
+	_, err = builder.BuildSignedOrder(s, other, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// another signer of the same order doesn't get the cached signature
+	var otherCalls atomic.Int32
+	_, err = builder.BuildSignedOrder(countingSigner(common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"), &otherCalls), orderData, model.CTFExchange)
+	assert.ErrorIs(t, err, model.ErrInvalidSignature)
+	assert.Equal(t, int32(1), otherCalls.Load())
+
+	// the signature expires after the ttl
+	clock.Advance(time.Second)
+	fourth, err := builder.BuildSignedOrder(s, orderData, model.CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, first, fourth)
+
+	// without the option every build signs
+	calls.Store(0)
+	builder = NewExchangeOrderBuilderImpl(chainId, func() int64 { return salt })
+	for i := 0; i < 3; i++ {
+		_, err = builder.BuildSignedOrder(s, orderData, model.CTFExchange)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestSignatureCacheEviction(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	cache := newSignatureCache(time.Minute)
+	signature := []byte{0x01}
+
+	cache.put(signerAddress, common.HexToHash("0x1"), signature, now)
+	cache.put(signerAddress, common.HexToHash("0x2"), signature, now)
+
+	// an expired entry is dropped when looked up, the others are left to the sweep
+	later := now.Add(time.Minute)
+	_, ok := cache.get(signerAddress, common.HexToHash("0x1"), later)
+	assert.False(t, ok)
+	assert.Len(t, cache.entries, 1)
+
+	// inserts don't scan the cache until the sweep interval
+	for i := 3; i < signatureCacheSweepInterval; i++ {
+		cache.put(signerAddress, common.BigToHash(big.NewInt(int64(i))), signature, later)
+	}
+	assert.Len(t, cache.entries, signatureCacheSweepInterval-2)
+	_, ok = cache.get(signerAddress, common.BigToHash(big.NewInt(3)), later)
+	assert.True(t, ok)
+
+	// the sweep drops the expired entries
+	cache.put(signerAddress, common.BigToHash(big.NewInt(signatureCacheSweepInterval)), signature, later)
+	assert.Len(t, cache.entries, signatureCacheSweepInterval-2)
+	_, ok = cache.entries[signatureCacheKey{signer: signerAddress, orderHash: common.HexToHash("0x2")}]
+	assert.False(t, ok)
+}
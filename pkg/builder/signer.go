@@ -1,15 +1,41 @@
 package builder
 
 import (
-	"errors"
+	"fmt"
 
-	"github.com/ivanzzeth/ethsig"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/signer"
 )
 
-var ErrInvalidSignatureLen = errors.New("invalid signature length")
+var (
+	ErrInvalidSignatureLen = model.ErrInvalidSignatureLen
+	ErrInvalidSignatureV   = model.ErrInvalidSignatureV
+)
 
 // Signer is an interface for signing hashed data
-type Signer interface {
-	ethsig.TypedDataSigner
-	ethsig.AddressGetter
+type Signer = signer.Signer
+
+// recoverAddress recovers the address that signed the hash.
+// The recovery id of the signature can be either 0/1 or 27/28.
+func recoverAddress(hash common.Hash, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidSignatureLen, crypto.SignatureLength, len(signature))
+	}
+
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	if sig[crypto.RecoveryIDOffset] > 1 {
+		return common.Address{}, fmt.Errorf("%w: %d", ErrInvalidSignatureV, signature[crypto.RecoveryIDOffset])
+	}
+
+	publicKey, err := crypto.SigToPub(hash[:], sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*publicKey), nil
 }
@@ -14,6 +14,8 @@ type Contracts struct {
 	NegRiskAdapter   common.Address
 	Collateral       common.Address
 	Conditional      common.Address
+	ProxyFactory     common.Address
+	SafeFactory      common.Address
 }
 
 var (
@@ -25,6 +27,8 @@ var (
 		NegRiskAdapter:   common.HexToAddress("0xd91E80cF2E7be2e162c6513ceD06f1dD0dA35296"),
 		Collateral:       common.HexToAddress("0x9c4e1703476e875070ee25b56a58b008cfb8fa78"),
 		Conditional:      common.HexToAddress("0x69308FB512518e39F9b16112fA8d994F4e2Bf8bB"),
+		// No proxy wallet factory is deployed on Amoy
+		SafeFactory: common.HexToAddress("0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b"),
 	}
 
 	_MATIC_CONTRACTS = &Contracts{
@@ -35,6 +39,8 @@ var (
 		NegRiskAdapter:   common.HexToAddress("0xd91E80cF2E7be2e162c6513ceD06f1dD0dA35296"),
 		Collateral:       common.HexToAddress("0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174"),
 		Conditional:      common.HexToAddress("0x4D97DCd97eC945f40cF65F87097ACe5EA0476045"),
+		ProxyFactory:     common.HexToAddress("0xaB45c5A4B0c941a2F231C04C3f49182e1A254052"),
+		SafeFactory:      common.HexToAddress("0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b"),
 	}
 )
 
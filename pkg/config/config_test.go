@@ -18,6 +18,7 @@ func TestGetContracts(t *testing.T) {
 			NegRiskAdapter:   common.HexToAddress("0xd91E80cF2E7be2e162c6513ceD06f1dD0dA35296"),
 			Collateral:       common.HexToAddress("0x9c4e1703476e875070ee25b56a58b008cfb8fa78"),
 			Conditional:      common.HexToAddress("0x69308FB512518e39F9b16112fA8d994F4e2Bf8bB"),
+			SafeFactory:      common.HexToAddress("0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b"),
 		}
 
 		matic = &Contracts{
@@ -28,6 +29,8 @@ func TestGetContracts(t *testing.T) {
 			NegRiskFeeModule: common.HexToAddress("0xB768891e3130F6dF18214Ac804d4DB76c2C37730"),
 			NegRiskExchange:  common.HexToAddress("0xC5d563A36AE78145C45a50134d48A1215220f80a"),
 			NegRiskAdapter:   common.HexToAddress("0xd91E80cF2E7be2e162c6513ceD06f1dD0dA35296"),
+			ProxyFactory:     common.HexToAddress("0xaB45c5A4B0c941a2F231C04C3f49182e1A254052"),
+			SafeFactory:      common.HexToAddress("0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b"),
 		}
 	)
 
@@ -41,6 +44,8 @@ func TestGetContracts(t *testing.T) {
 	assert.True(t, bytes.Equal(c.NegRiskFeeModule[:], amoy.NegRiskFeeModule[:]))
 	assert.True(t, bytes.Equal(c.NegRiskExchange[:], amoy.NegRiskExchange[:]))
 	assert.True(t, bytes.Equal(c.NegRiskAdapter[:], amoy.NegRiskAdapter[:]))
+	assert.True(t, bytes.Equal(c.ProxyFactory[:], amoy.ProxyFactory[:]))
+	assert.True(t, bytes.Equal(c.SafeFactory[:], amoy.SafeFactory[:]))
 
 	c, err = GetContracts(137)
 	assert.NotNil(t, c)
@@ -52,6 +57,8 @@ func TestGetContracts(t *testing.T) {
 	assert.True(t, bytes.Equal(c.NegRiskFeeModule[:], matic.NegRiskFeeModule[:]))
 	assert.True(t, bytes.Equal(c.NegRiskExchange[:], matic.NegRiskExchange[:]))
 	assert.True(t, bytes.Equal(c.NegRiskAdapter[:], matic.NegRiskAdapter[:]))
+	assert.True(t, bytes.Equal(c.ProxyFactory[:], matic.ProxyFactory[:]))
+	assert.True(t, bytes.Equal(c.SafeFactory[:], matic.SafeFactory[:]))
 
 	c, err = GetContracts(100000)
 	assert.Nil(t, c)
@@ -0,0 +1,199 @@
+package model
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Decimals of the collateral token (USDC)
+	CollateralTokenDecimals = 6
+
+	// Decimals of the CTF ERC1155 conditional tokens
+	ConditionalTokenDecimals = 6
+
+	// Decimals of the order size (number of shares) accepted by the CLOB
+	OrderSizeDecimals = 2
+)
+
+// TokenDecimals are the decimals of the tokens exchanged by the orders
+type TokenDecimals struct {
+	// Decimals of the collateral token
+	Collateral int32
+
+	// Decimals of the CTF ERC1155 conditional tokens
+	ConditionalToken int32
+}
+
+// Polymarket's token decimals, 6 decimals USDC collateral and 6 decimals conditional tokens
+var DefaultTokenDecimals = TokenDecimals{
+	Collateral:       CollateralTokenDecimals,
+	ConditionalToken: ConditionalTokenDecimals,
+}
+
+// Calculates the maker and taker amounts of a limit order.
+//
+// If BUY, the maker amount is the collateral paid (price * size) and the taker amount is the shares received (size).
+// If SELL, the maker amount is the shares sold (size) and the taker amount is the collateral received (price * size).
+//
+// Following Polymarket's convention the size is rounded down to OrderSizeDecimals,
+// then both amounts are rounded down to the token decimals.
+func CalcOrderAmounts(side Side, price, size decimal.Decimal) (makerAmount, takerAmount *big.Int, err error) {
+	return DefaultTokenDecimals.CalcOrderAmounts(side, price, size)
+}
+
+// Calculates the maker and taker amounts of a limit order with these token decimals, see CalcOrderAmounts.
+func (d TokenDecimals) CalcOrderAmounts(side Side, price, size decimal.Decimal) (makerAmount, takerAmount *big.Int, err error) {
+	if err := validatePrice(price); err != nil {
+		return nil, nil, err
+	}
+
+	size = size.RoundFloor(OrderSizeDecimals)
+	if !size.IsPositive() {
+		return nil, nil, fmt.Errorf("%w: size %s", ErrZeroAmount, size.String())
+	}
+
+	shares := toTokenDecimals(size, d.ConditionalToken)
+	collateral := toTokenDecimals(size.Mul(price), d.Collateral)
+
+	switch side {
+	case BUY:
+		makerAmount, takerAmount = collateral, shares
+	case SELL:
+		makerAmount, takerAmount = shares, collateral
+	default:
+		return nil, nil, fmt.Errorf("%w: %d", ErrInvalidSide, side)
+	}
+
+	if makerAmount.Sign() <= 0 || takerAmount.Sign() <= 0 {
+		return nil, nil, fmt.Errorf("%w: %s at price %s rounds to zero", ErrZeroAmount, size.String(), price.String())
+	}
+
+	return makerAmount, takerAmount, nil
+}
+
+// Calculates the maker and taker amounts of a market order.
+//
+// If BUY, amount is the collateral to spend and the taker amount is the shares received at price.
+// If SELL, amount is the shares to sell and the taker amount is the collateral received at price.
+//
+// Both amounts are rounded down to the token decimals.
+func CalcMarketOrderAmounts(side Side, amount, price decimal.Decimal) (makerAmount, takerAmount *big.Int, err error) {
+	return DefaultTokenDecimals.CalcMarketOrderAmounts(side, amount, price)
+}
+
+// Calculates the maker and taker amounts of a market order with these token decimals, see CalcMarketOrderAmounts.
+func (d TokenDecimals) CalcMarketOrderAmounts(side Side, amount, price decimal.Decimal) (makerAmount, takerAmount *big.Int, err error) {
+	if !amount.IsPositive() {
+		return nil, nil, fmt.Errorf("%w: %s", ErrZeroAmount, amount.String())
+	}
+	if err := validatePrice(price); err != nil {
+		return nil, nil, err
+	}
+
+	switch side {
+	case BUY:
+		makerAmount = toTokenDecimals(amount, d.Collateral)
+		takerAmount = toTokenDecimals(amount.Div(price), d.ConditionalToken)
+	case SELL:
+		makerAmount = toTokenDecimals(amount, d.ConditionalToken)
+		takerAmount = toTokenDecimals(amount.Mul(price), d.Collateral)
+	default:
+		return nil, nil, fmt.Errorf("%w: %d", ErrInvalidSide, side)
+	}
+
+	if makerAmount.Sign() <= 0 || takerAmount.Sign() <= 0 {
+		return nil, nil, fmt.Errorf("%w: %s rounds to zero", ErrZeroAmount, amount.String())
+	}
+
+	return makerAmount, takerAmount, nil
+}
+
+// Calculates the price implied by the order amounts, i.e the collateral paid or received per share.
+//
+// If BUY, the price is makerAmount / takerAmount.
+// If SELL, the price is takerAmount / makerAmount.
+//
+// Returns zero if the shares amount is zero.
+func ImpliedPrice(side Side, makerAmount, takerAmount *big.Int) decimal.Decimal {
+	return DefaultTokenDecimals.ImpliedPrice(side, makerAmount, takerAmount)
+}
+
+// Calculates the price implied by the order amounts with these token decimals, see ImpliedPrice.
+func (d TokenDecimals) ImpliedPrice(side Side, makerAmount, takerAmount *big.Int) decimal.Decimal {
+	collateral, shares := makerAmount, takerAmount
+	if side == SELL {
+		collateral, shares = takerAmount, makerAmount
+	}
+	if collateral == nil || shares == nil || shares.Sign() == 0 {
+		return decimal.Zero
+	}
+
+	return decimal.NewFromBigInt(collateral, -d.Collateral).Div(decimal.NewFromBigInt(shares, -d.ConditionalToken))
+}
+
+// Price returns the price of one share in collateral implied by the order amounts,
+// the reverse of CalcOrderAmounts with Polymarket's token decimals.
+// Returns zero if the shares amount is zero or nil.
+func (o *Order) Price() decimal.Decimal {
+	return DefaultTokenDecimals.OrderPrice(o)
+}
+
+// Size returns the number of shares bought or sold by the order,
+// the reverse of CalcOrderAmounts with Polymarket's token decimals.
+func (o *Order) Size() decimal.Decimal {
+	return DefaultTokenDecimals.OrderSize(o)
+}
+
+// Returns the price implied by the order amounts with these token decimals, see Order.Price.
+func (d TokenDecimals) OrderPrice(o *Order) decimal.Decimal {
+	side := BUY
+	if o.IsSell() {
+		side = SELL
+	}
+	return d.ImpliedPrice(side, o.MakerAmount, o.TakerAmount)
+}
+
+// Returns the number of shares of the order with these token decimals, see Order.Size.
+func (d TokenDecimals) OrderSize(o *Order) decimal.Decimal {
+	shares := o.TakerAmount
+	if o.IsSell() {
+		shares = o.MakerAmount
+	}
+	if shares == nil {
+		return decimal.Zero
+	}
+	return decimal.NewFromBigInt(shares, -d.ConditionalToken)
+}
+
+// Rounds the price to the nearest multiple of the tick size.
+// A non positive tick size leaves the price unchanged.
+func RoundToTickSize(price decimal.Decimal, tickSize decimal.Decimal) decimal.Decimal {
+	if !tickSize.IsPositive() {
+		return price
+	}
+	return price.Div(tickSize).Round(0).Mul(tickSize)
+}
+
+// Reports whether the price is a multiple of the tick size.
+func IsPriceOnTick(price decimal.Decimal, tickSize decimal.Decimal) bool {
+	if !tickSize.IsPositive() {
+		return true
+	}
+	return price.Mod(tickSize).IsZero()
+}
+
+// validatePrice checks that the price is in the range (0, 1), the same range ValidateOrderData
+// requires of the price implied by the amounts
+func validatePrice(price decimal.Decimal) error {
+	if !price.IsPositive() || !price.LessThan(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w: %s", ErrPriceOutOfRange, price.String())
+	}
+	return nil
+}
+
+func toTokenDecimals(amount decimal.Decimal, decimals int32) *big.Int {
+	return amount.Shift(decimals).Floor().BigInt()
+}
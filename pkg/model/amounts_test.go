@@ -0,0 +1,190 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcMarketOrderAmounts(t *testing.T) {
+	// buy: spend 10 USDC at 0.5
+	makerAmount, takerAmount, err := CalcMarketOrderAmounts(BUY, decimal.RequireFromString("10"), decimal.RequireFromString("0.5"))
+	assert.NoError(t, err)
+	assert.Equal(t, "10000000", makerAmount.String())
+	assert.Equal(t, "20000000", takerAmount.String())
+
+	// sell: sell 10 shares at 0.5
+	makerAmount, takerAmount, err = CalcMarketOrderAmounts(SELL, decimal.RequireFromString("10"), decimal.RequireFromString("0.5"))
+	assert.NoError(t, err)
+	assert.Equal(t, "10000000", makerAmount.String())
+	assert.Equal(t, "5000000", takerAmount.String())
+
+	// amounts are rounded down
+	makerAmount, takerAmount, err = CalcMarketOrderAmounts(BUY, decimal.RequireFromString("1"), decimal.RequireFromString("0.3"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1000000", makerAmount.String())
+	assert.Equal(t, "3333333", takerAmount.String())
+
+	// zero or negative amounts
+	_, _, err = CalcMarketOrderAmounts(BUY, decimal.Zero, decimal.RequireFromString("0.5"))
+	assert.ErrorIs(t, err, ErrZeroAmount)
+
+	_, _, err = CalcMarketOrderAmounts(SELL, decimal.RequireFromString("-1"), decimal.RequireFromString("0.5"))
+	assert.ErrorIs(t, err, ErrZeroAmount)
+
+	_, _, err = CalcMarketOrderAmounts(BUY, decimal.RequireFromString("0.0000001"), decimal.RequireFromString("0.5"))
+	assert.ErrorIs(t, err, ErrZeroAmount)
+
+	// invalid price
+	_, _, err = CalcMarketOrderAmounts(BUY, decimal.RequireFromString("10"), decimal.Zero)
+	assert.ErrorIs(t, err, ErrPriceOutOfRange)
+
+	_, _, err = CalcMarketOrderAmounts(BUY, decimal.RequireFromString("10"), decimal.RequireFromString("1"))
+	assert.ErrorIs(t, err, ErrPriceOutOfRange)
+
+	_, _, err = CalcMarketOrderAmounts(BUY, decimal.RequireFromString("10"), decimal.RequireFromString("1.01"))
+	assert.ErrorIs(t, err, ErrPriceOutOfRange)
+}
+
+func TestCalcOrderAmounts(t *testing.T) {
+	cases := []struct {
+		side        Side
+		price       string
+		size        string
+		makerAmount string
+		takerAmount string
+	}{
+		{BUY, "0.52", "100", "52000000", "100000000"},
+		{SELL, "0.52", "100", "100000000", "52000000"},
+		// size is rounded down to 2 decimals
+		{BUY, "0.5", "100.129", "50060000", "100120000"},
+		{SELL, "0.5", "100.129", "100120000", "50060000"},
+		// collateral is rounded down to 6 decimals
+		{BUY, "0.333", "3.33", "1108890", "3330000"},
+		{BUY, "0.0001", "0.01", "1", "10000"},
+		{SELL, "0.123457", "1.11", "1110000", "137037"},
+	}
+
+	for _, c := range cases {
+		makerAmount, takerAmount, err := CalcOrderAmounts(c.side, decimal.RequireFromString(c.price), decimal.RequireFromString(c.size))
+		assert.NoError(t, err)
+		assert.Equal(t, c.makerAmount, makerAmount.String(), "%d %s x %s", c.side, c.price, c.size)
+		assert.Equal(t, c.takerAmount, takerAmount.String(), "%d %s x %s", c.side, c.price, c.size)
+	}
+
+	// size rounds to zero
+	_, _, err := CalcOrderAmounts(BUY, decimal.RequireFromString("0.5"), decimal.RequireFromString("0.009"))
+	assert.ErrorIs(t, err, ErrZeroAmount)
+
+	// collateral rounds to zero
+	_, _, err = CalcOrderAmounts(SELL, decimal.RequireFromString("0.00001"), decimal.RequireFromString("0.01"))
+	assert.ErrorIs(t, err, ErrZeroAmount)
+
+	// price outside (0, 1)
+	for _, price := range []string{"0", "-0.5", "1", "1.000001", "2"} {
+		_, _, err = CalcOrderAmounts(BUY, decimal.RequireFromString(price), decimal.RequireFromString("10"))
+		assert.ErrorIs(t, err, ErrPriceOutOfRange, price)
+	}
+}
+
+func TestImpliedPrice(t *testing.T) {
+	assert.Equal(t, "0.52", ImpliedPrice(BUY, big.NewInt(52000000), big.NewInt(100000000)).String())
+	assert.Equal(t, "0.52", ImpliedPrice(SELL, big.NewInt(100000000), big.NewInt(52000000)).String())
+	assert.Equal(t, "2", ImpliedPrice(BUY, big.NewInt(100000000), big.NewInt(50000000)).String())
+
+	// no shares
+	assert.True(t, ImpliedPrice(BUY, big.NewInt(100000000), big.NewInt(0)).IsZero())
+	assert.True(t, ImpliedPrice(SELL, big.NewInt(0), big.NewInt(100000000)).IsZero())
+}
+
+func TestOrderPriceSize(t *testing.T) {
+	for _, tc := range []struct {
+		side  Side
+		price string
+		size  string
+	}{
+		{BUY, "0.52", "100"},
+		{SELL, "0.52", "100"},
+		{BUY, "0.014", "33.33"},
+		{SELL, "0.999", "12.34"},
+		{BUY, "0.1235", "7"},
+	} {
+		makerAmount, takerAmount, err := CalcOrderAmounts(tc.side, decimal.RequireFromString(tc.price), decimal.RequireFromString(tc.size))
+		assert.NoError(t, err)
+		order := &Order{MakerAmount: makerAmount, TakerAmount: takerAmount, Side: tc.side.Int()}
+
+		assert.True(t, decimal.RequireFromString(tc.price).Equal(order.Price()), "%s price %s", tc.side, order.Price())
+		assert.True(t, decimal.RequireFromString(tc.size).Equal(order.Size()), "%s size %s", tc.side, order.Size())
+	}
+
+	// with other token decimals
+	decimals := TokenDecimals{Collateral: 18, ConditionalToken: 6}
+	order := &Order{MakerAmount: big.NewInt(100000000), TakerAmount: new(big.Int).Mul(big.NewInt(52), big.NewInt(1e18)), Side: SELL.Int()}
+	assert.Equal(t, "0.52", decimals.OrderPrice(order).String())
+	assert.Equal(t, "100", decimals.OrderSize(order).String())
+
+	// missing amounts
+	assert.True(t, (&Order{}).Price().IsZero())
+	assert.True(t, (&Order{}).Size().IsZero())
+}
+
+func TestRoundToTickSize(t *testing.T) {
+	cases := []struct {
+		price    string
+		tickSize string
+		expected string
+	}{
+		{"0.46", "0.1", "0.5"},
+		{"0.44", "0.1", "0.4"},
+		{"0.5", "0.1", "0.5"},
+		{"0.523", "0.01", "0.52"},
+		{"0.525", "0.01", "0.53"},
+		{"0.52", "0.01", "0.52"},
+		{"0.5234", "0.001", "0.523"},
+		{"0.5235", "0.001", "0.524"},
+		{"0.001", "0.001", "0.001"},
+		// no tick size
+		{"0.5234", "0", "0.5234"},
+	}
+
+	for _, c := range cases {
+		rounded := RoundToTickSize(decimal.RequireFromString(c.price), decimal.RequireFromString(c.tickSize))
+		assert.True(t, decimal.RequireFromString(c.expected).Equal(rounded), "%s on %s: %s", c.price, c.tickSize, rounded.String())
+		assert.True(t, IsPriceOnTick(rounded, decimal.RequireFromString(c.tickSize)))
+	}
+
+	assert.True(t, IsPriceOnTick(decimal.RequireFromString("0.5"), decimal.RequireFromString("0.1")))
+	assert.False(t, IsPriceOnTick(decimal.RequireFromString("0.55"), decimal.RequireFromString("0.1")))
+	assert.True(t, IsPriceOnTick(decimal.RequireFromString("0.55"), decimal.RequireFromString("0.01")))
+	assert.False(t, IsPriceOnTick(decimal.RequireFromString("0.555"), decimal.RequireFromString("0.01")))
+	assert.True(t, IsPriceOnTick(decimal.RequireFromString("0.555"), decimal.RequireFromString("0.001")))
+	assert.False(t, IsPriceOnTick(decimal.RequireFromString("0.5555"), decimal.RequireFromString("0.001")))
+}
+
+func TestTokenDecimals(t *testing.T) {
+	decimals := TokenDecimals{Collateral: 18, ConditionalToken: 6}
+
+	// BUY 100 shares at 0.52 with 18 decimals collateral
+	makerAmount, takerAmount, err := decimals.CalcOrderAmounts(BUY, decimal.RequireFromString("0.52"), decimal.RequireFromString("100"))
+	assert.NoError(t, err)
+	assert.Equal(t, "52000000000000000000", makerAmount.String())
+	assert.Equal(t, "100000000", takerAmount.String())
+	assert.Equal(t, "0.52", decimals.ImpliedPrice(BUY, makerAmount, takerAmount).String())
+
+	// SELL 10 shares at 0.5
+	makerAmount, takerAmount, err = decimals.CalcMarketOrderAmounts(SELL, decimal.RequireFromString("10"), decimal.RequireFromString("0.5"))
+	assert.NoError(t, err)
+	assert.Equal(t, "10000000", makerAmount.String())
+	assert.Equal(t, "5000000000000000000", takerAmount.String())
+	assert.Equal(t, "0.5", decimals.ImpliedPrice(SELL, makerAmount, takerAmount).String())
+
+	// the package functions use Polymarket's decimals
+	makerAmount, takerAmount, err = DefaultTokenDecimals.CalcOrderAmounts(BUY, decimal.RequireFromString("0.52"), decimal.RequireFromString("100"))
+	assert.NoError(t, err)
+	expectedMaker, expectedTaker, err := CalcOrderAmounts(BUY, decimal.RequireFromString("0.52"), decimal.RequireFromString("100"))
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMaker, makerAmount)
+	assert.Equal(t, expectedTaker, takerAmount)
+}
@@ -0,0 +1,34 @@
+package model
+
+import "math/big"
+
+// CollateralToken is the token returned by RequiredMakerBalance for BUY orders,
+// the CLOB asset type of the collateral balance
+const CollateralToken = "COLLATERAL"
+
+// Returns the token and the amount the maker must hold for the order to be fully filled.
+// The fees are charged on the proceeds, so the maker only needs the maker amount.
+//
+// If BUY, the maker pays collateral, the token is CollateralToken.
+// If SELL, the maker sells conditional tokens, the token is the decimal token id.
+//
+// @param order
+//
+// @returns the token and the amount in its smallest unit, an empty token and a nil amount
+// if the order side is invalid
+func RequiredMakerBalance(order *Order) (token string, amount *big.Int) {
+	side, err := sideFromInt(order.Side)
+	if err != nil {
+		return "", nil
+	}
+
+	amount = new(big.Int)
+	if order.MakerAmount != nil {
+		amount.Set(order.MakerAmount)
+	}
+
+	if side == BUY {
+		return CollateralToken, amount
+	}
+	return bigIntToString(order.TokenId), amount
+}
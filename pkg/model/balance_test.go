@@ -0,0 +1,41 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequiredMakerBalance(t *testing.T) {
+	tokenId, _ := new(big.Int).SetString("71321045679252212594626385532706912750332728571942532289631379312455583992563", 10)
+	order := &Order{
+		TokenId:     tokenId,
+		MakerAmount: big.NewInt(50000000),
+		TakerAmount: big.NewInt(100000000),
+		Side:        BUY.Int(),
+		FeeRateBps:  big.NewInt(100),
+	}
+
+	// buying 100 shares at 0.5 costs 50 USDC
+	token, amount := RequiredMakerBalance(order)
+	assert.Equal(t, CollateralToken, token)
+	assert.Equal(t, big.NewInt(50000000), amount)
+
+	// the amount is a copy
+	amount.SetInt64(0)
+	assert.Equal(t, big.NewInt(50000000), order.MakerAmount)
+
+	// selling 100 shares at 0.5 needs the 100 shares
+	order.MakerAmount, order.TakerAmount = big.NewInt(100000000), big.NewInt(50000000)
+	order.Side = SELL.Int()
+	token, amount = RequiredMakerBalance(order)
+	assert.Equal(t, tokenId.String(), token)
+	assert.Equal(t, big.NewInt(100000000), amount)
+
+	// invalid side
+	order.Side = big.NewInt(2)
+	token, amount = RequiredMakerBalance(order)
+	assert.Equal(t, "", token)
+	assert.Nil(t, amount)
+}
@@ -0,0 +1,131 @@
+package model
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/config"
+)
+
+const (
+	PolygonChainId = 137
+	AmoyChainId    = 80002
+)
+
+// ChainConfig is the chain dependent configuration of the EIP712 domain
+type ChainConfig struct {
+	// Id of the chain the exchange contracts are deployed on
+	ChainID *big.Int
+
+	// Address of the CTF Exchange
+	ExchangeAddress common.Address
+
+	// Address of the Neg Risk CTF Exchange
+	NegRiskExchangeAddress common.Address
+
+	// Address of the factory of the POLY_PROXY wallets. Optional, zero if not deployed
+	ProxyFactoryAddress common.Address
+
+	// Address of the factory of the POLY_GNOSIS_SAFE wallets. Optional, zero if not deployed
+	SafeFactoryAddress common.Address
+
+	// Decimals of the collateral token. Optional, defaults to CollateralTokenDecimals
+	CollateralDecimals int32
+
+	// Decimals of the conditional tokens. Optional, defaults to ConditionalTokenDecimals
+	ConditionalTokenDecimals int32
+
+	// EIP712 domain name of the exchanges, e.g for an exchange fork. Optional, defaults to DefaultDomainName
+	DomainName string
+
+	// EIP712 domain version of the exchanges. Optional, defaults to DefaultDomainVersion
+	DomainVersion string
+
+	// Maximum fee rate, in basis points, of the orders. Optional, defaults to MaxFeeRateBps
+	MaxFeeRateBps int64
+}
+
+// Returns the built-in config of the chain
+func GetChainConfig(chainId int64) (*ChainConfig, error) {
+	contracts, err := config.GetContracts(chainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChainConfig{
+		ChainID:                  big.NewInt(chainId),
+		ExchangeAddress:          contracts.Exchange,
+		NegRiskExchangeAddress:   contracts.NegRiskExchange,
+		ProxyFactoryAddress:      contracts.ProxyFactory,
+		SafeFactoryAddress:       contracts.SafeFactory,
+		CollateralDecimals:       CollateralTokenDecimals,
+		ConditionalTokenDecimals: ConditionalTokenDecimals,
+		DomainName:               DefaultDomainName,
+		DomainVersion:            DefaultDomainVersion,
+		MaxFeeRateBps:            MaxFeeRateBps,
+	}, nil
+}
+
+// Returns the built-in config of Polygon mainnet
+func MainnetChainConfig() *ChainConfig {
+	c, _ := GetChainConfig(PolygonChainId)
+	return c
+}
+
+// Returns the built-in config of Polygon Amoy testnet
+func AmoyChainConfig() *ChainConfig {
+	c, _ := GetChainConfig(AmoyChainId)
+	return c
+}
+
+// Returns the address of the verifying contract
+func (c *ChainConfig) VerifyingContractAddress(contract VerifyingContract) (common.Address, error) {
+	var address common.Address
+	switch contract {
+	case CTFExchange:
+		address = c.ExchangeAddress
+	case NegRiskCTFExchange:
+		address = c.NegRiskExchangeAddress
+	default:
+		return common.Address{}, fmt.Errorf("%w: %d", ErrInvalidContract, contract)
+	}
+
+	if address == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("no address configured for contract %d on chain %v", contract, c.ChainID)
+	}
+
+	return address, nil
+}
+
+// Returns the token decimals of the chain, unset decimals default to Polymarket's
+func (c *ChainConfig) TokenDecimals() TokenDecimals {
+	decimals := DefaultTokenDecimals
+	if c.CollateralDecimals != 0 {
+		decimals.Collateral = c.CollateralDecimals
+	}
+	if c.ConditionalTokenDecimals != 0 {
+		decimals.ConditionalToken = c.ConditionalTokenDecimals
+	}
+	return decimals
+}
+
+// Returns the EIP712 domain name and version of the exchanges, unset values default to Polymarket's
+func (c *ChainConfig) EIP712Domain() (name, version string) {
+	name, version = DefaultDomainName, DefaultDomainVersion
+	if c.DomainName != "" {
+		name = c.DomainName
+	}
+	if c.DomainVersion != "" {
+		version = c.DomainVersion
+	}
+	return name, version
+}
+
+// Returns the maximum fee rate of the orders in basis points, unset defaults to MaxFeeRateBps
+func (c *ChainConfig) MaxFeeRate() int64 {
+	if c.MaxFeeRateBps != 0 {
+		return c.MaxFeeRateBps
+	}
+	return MaxFeeRateBps
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetChainConfig(t *testing.T) {
+	c := MainnetChainConfig()
+	assert.Equal(t, int64(137), c.ChainID.Int64())
+	assert.Equal(t, common.HexToAddress("0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E"), c.ExchangeAddress)
+	assert.Equal(t, common.HexToAddress("0xC5d563A36AE78145C45a50134d48A1215220f80a"), c.NegRiskExchangeAddress)
+
+	c = AmoyChainConfig()
+	assert.Equal(t, int64(80002), c.ChainID.Int64())
+	assert.Equal(t, common.HexToAddress("0xdFE02Eb6733538f8Ea35D585af8DE5958AD99E40"), c.ExchangeAddress)
+	assert.Equal(t, common.HexToAddress("0xC5d563A36AE78145C45a50134d48A1215220f80a"), c.NegRiskExchangeAddress)
+
+	// configs are not shared
+	MainnetChainConfig().ChainID.SetInt64(1)
+	assert.Equal(t, int64(137), MainnetChainConfig().ChainID.Int64())
+
+	// wrong network
+	c, err := GetChainConfig(1)
+	assert.Nil(t, c)
+	assert.Error(t, err)
+}
+
+func TestChainConfigVerifyingContractAddress(t *testing.T) {
+	c := AmoyChainConfig()
+
+	address, err := c.VerifyingContractAddress(CTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, c.ExchangeAddress, address)
+
+	address, err = c.VerifyingContractAddress(NegRiskCTFExchange)
+	assert.NoError(t, err)
+	assert.Equal(t, c.NegRiskExchangeAddress, address)
+
+	// wrong contract
+	_, err = c.VerifyingContractAddress(VerifyingContract(100))
+	assert.Error(t, err)
+
+	// missing address
+	c.NegRiskExchangeAddress = common.Address{}
+	_, err = c.VerifyingContractAddress(NegRiskCTFExchange)
+	assert.Error(t, err)
+}
+
+func TestChainConfigTokenDecimals(t *testing.T) {
+	assert.Equal(t, DefaultTokenDecimals, MainnetChainConfig().TokenDecimals())
+	assert.Equal(t, DefaultTokenDecimals, AmoyChainConfig().TokenDecimals())
+
+	// unset decimals default to Polymarket's
+	assert.Equal(t, DefaultTokenDecimals, (&ChainConfig{}).TokenDecimals())
+
+	c := MainnetChainConfig()
+	c.CollateralDecimals = 18
+	assert.Equal(t, TokenDecimals{Collateral: 18, ConditionalToken: 6}, c.TokenDecimals())
+}
+
+func TestChainConfigEIP712Domain(t *testing.T) {
+	name, version := MainnetChainConfig().EIP712Domain()
+	assert.Equal(t, "Polymarket CTF Exchange", name)
+	assert.Equal(t, "1", version)
+
+	// unset values default to Polymarket's
+	name, version = (&ChainConfig{}).EIP712Domain()
+	assert.Equal(t, DefaultDomainName, name)
+	assert.Equal(t, DefaultDomainVersion, version)
+
+	name, version = (&ChainConfig{DomainName: "Fork Exchange", DomainVersion: "2"}).EIP712Domain()
+	assert.Equal(t, "Fork Exchange", name)
+	assert.Equal(t, "2", version)
+}
+
+func TestChainConfigMaxFeeRate(t *testing.T) {
+	assert.Equal(t, int64(MaxFeeRateBps), MainnetChainConfig().MaxFeeRate())
+	assert.Equal(t, int64(MaxFeeRateBps), AmoyChainConfig().MaxFeeRate())
+
+	// unset cap defaults to the exchange's
+	assert.Equal(t, int64(MaxFeeRateBps), (&ChainConfig{}).MaxFeeRate())
+	assert.Equal(t, int64(500), (&ChainConfig{MaxFeeRateBps: 500}).MaxFeeRate())
+}
@@ -0,0 +1,28 @@
+package model
+
+import "errors"
+
+var (
+	ErrZeroAmount           = errors.New("amount must be greater than zero")
+	ErrPriceOutOfRange      = errors.New("price out of range")
+	ErrInvalidSigner        = errors.New("invalid signer")
+	ErrPriceNotOnTick       = errors.New("price is not a multiple of the tick size")
+	ErrExpired              = errors.New("order expiration is in the past")
+	ErrInvalidExpiration    = errors.New("invalid expiration")
+	ErrInvalidSignatureType = errors.New("invalid signature type")
+	ErrInvalidSide          = errors.New("invalid side")
+	ErrInvalidContract      = errors.New("invalid contract")
+	ErrInvalidSalt          = errors.New("invalid salt")
+	ErrInvalidFeeRate       = errors.New("invalid fee rate")
+	ErrFeeTooHigh           = errors.New("fee rate above the maximum")
+	ErrContractSignature    = errors.New("contract wallet signatures can only be verified onchain")
+	ErrInvalidTaker         = errors.New("invalid taker")
+	ErrInvalidSignatureLen  = errors.New("invalid signature length")
+	ErrInvalidSignatureV    = errors.New("invalid signature recovery id")
+	ErrInvalidSignature     = errors.New("signature was not produced by the order signer")
+	ErrOrderMismatch        = errors.New("order does not match the expectation")
+	ErrInvalidOrderHash     = errors.New("invalid order hash")
+	ErrMissingOrderField    = errors.New("missing order field")
+	ErrInvalidTokenId       = errors.New("invalid token id")
+	ErrSlippageExceeded     = errors.New("order price is worse than the worst acceptable price")
+)
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Security buffer applied by the CLOB to GTD orders: an order is only accepted if its
+// expiration is at least one minute in the future, so an order meant to live for d
+// must expire at now + GTDSecurityBuffer + d.
+const GTDSecurityBuffer = time.Minute
+
+// Returns the expiration, in unix seconds, of an order expiring at t
+func ExpirationFromTime(t time.Time) *big.Int {
+	return big.NewInt(t.Unix())
+}
+
+// Returns the expiration, in unix seconds, of an order expiring d after now.
+// A negative duration returns ErrInvalidExpiration.
+func ExpirationFromDuration(d time.Duration, now time.Time) (*big.Int, error) {
+	if d < 0 {
+		return nil, fmt.Errorf("%w: negative duration %s", ErrInvalidExpiration, d)
+	}
+	return ExpirationFromTime(now.Add(d)), nil
+}
+
+// Returns the expiration of a GTD order valid for d after now,
+// including the CLOB's GTDSecurityBuffer. A negative duration returns ErrInvalidExpiration,
+// even when the security buffer would still put the expiration in the future.
+func GTDExpiration(d time.Duration, now time.Time) (*big.Int, error) {
+	if d < 0 {
+		return nil, fmt.Errorf("%w: negative duration %s", ErrInvalidExpiration, d)
+	}
+	return ExpirationFromDuration(GTDSecurityBuffer+d, now)
+}
+
+// IsExpired reports whether the order is expired at now. A zero or nil expiration
+// never expires, otherwise the order is expired once now is past its expiration, as the
+// exchange rejects it when block.timestamp > expiration. An order expiring exactly at now
+// is not expired yet, the same rule the builder applies with ErrExpired.
+func (o *Order) IsExpired(now time.Time) bool {
+	if o.Expiration == nil || o.Expiration.Sign() == 0 {
+		return false
+	}
+	return o.Expiration.Cmp(big.NewInt(now.Unix())) < 0
+}
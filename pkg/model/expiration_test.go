@@ -0,0 +1,55 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiration(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	assert.Equal(t, "1700000000", ExpirationFromTime(now).String())
+
+	expiration, err := ExpirationFromDuration(5*time.Minute, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "1700000300", expiration.String())
+
+	// sub second durations are truncated
+	expiration, err = ExpirationFromDuration(1500*time.Millisecond, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "1700000001", expiration.String())
+
+	// expire in 90 seconds
+	expiration, err = GTDExpiration(90*time.Second, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "1700000150", expiration.String())
+
+	// negative durations, including ones truncated to now or offset by the security buffer
+	for _, d := range []time.Duration{-5 * time.Minute, -30 * time.Second, -500 * time.Millisecond} {
+		_, err = ExpirationFromDuration(d, now)
+		assert.ErrorIs(t, err, ErrInvalidExpiration, d)
+
+		_, err = GTDExpiration(d, now)
+		assert.ErrorIs(t, err, ErrInvalidExpiration, d)
+	}
+}
+
+func TestOrderIsExpired(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	// zero expiration never expires
+	assert.False(t, (&Order{}).IsExpired(now))
+	assert.False(t, (&Order{Expiration: big.NewInt(0)}).IsExpired(now))
+
+	assert.False(t, (&Order{Expiration: ExpirationFromTime(now.Add(time.Second))}).IsExpired(now))
+	// expiring exactly at now, still fillable on chain
+	assert.False(t, (&Order{Expiration: ExpirationFromTime(now)}).IsExpired(now))
+	assert.True(t, (&Order{Expiration: ExpirationFromTime(now.Add(-time.Second))}).IsExpired(now))
+
+	// sub second instants are truncated
+	assert.False(t, (&Order{Expiration: ExpirationFromTime(now)}).IsExpired(now.Add(500*time.Millisecond)))
+	assert.True(t, (&Order{Expiration: ExpirationFromTime(now)}).IsExpired(now.Add(time.Second)))
+}
@@ -0,0 +1,61 @@
+package model
+
+import "math/big"
+
+var (
+	// Fixed point precision of the prices in the exchange contracts
+	feePriceOne = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	feeBpsDivisor = big.NewInt(10_000)
+)
+
+// Calculates the fee charged by the exchange when the order is fully filled,
+// following the contract's CalculatorHelper.calculateFee.
+//
+// The fee is symmetric around a price of 0.5, i.e it is proportional to min(price, 1 - price):
+// If BUY, the fee is charged on the token proceeds, in conditional tokens.
+// If SELL, the fee is charged on the collateral proceeds, in collateral.
+//
+// @param feeRateBps - fee rate of the order, in basis points
+//
+// @returns the fee in the token received by the maker, zero if the fee rate is zero
+func CalcFee(feeRateBps int, side Side, makerAmount, takerAmount *big.Int) *big.Int {
+	fee := new(big.Int)
+	if feeRateBps <= 0 || makerAmount == nil || takerAmount == nil {
+		return fee
+	}
+
+	var price, outcomeTokens *big.Int
+	switch side {
+	case BUY:
+		if takerAmount.Sign() <= 0 {
+			return fee
+		}
+		price = new(big.Int).Div(new(big.Int).Mul(makerAmount, feePriceOne), takerAmount)
+		outcomeTokens = takerAmount
+	case SELL:
+		if makerAmount.Sign() <= 0 {
+			return fee
+		}
+		price = new(big.Int).Div(new(big.Int).Mul(takerAmount, feePriceOne), makerAmount)
+		outcomeTokens = makerAmount
+	default:
+		return fee
+	}
+
+	if price.Sign() <= 0 || price.Cmp(feePriceOne) > 0 {
+		return fee
+	}
+
+	minPrice := new(big.Int).Sub(feePriceOne, price)
+	if price.Cmp(minPrice) < 0 {
+		minPrice = price
+	}
+
+	fee.Mul(big.NewInt(int64(feeRateBps)), minPrice)
+	fee.Mul(fee, outcomeTokens)
+	if side == BUY {
+		return fee.Div(fee, new(big.Int).Mul(price, feeBpsDivisor))
+	}
+	return fee.Div(fee, new(big.Int).Mul(feeBpsDivisor, feePriceOne))
+}
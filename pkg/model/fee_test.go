@@ -0,0 +1,42 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcFee(t *testing.T) {
+	tests := []struct {
+		name        string
+		feeRateBps  int
+		side        Side
+		makerAmount int64
+		takerAmount int64
+		expected    int64
+	}{
+		// buy 100 shares at 0.5, fee = 100 * 0.5 * 100 / (0.5 * 10000) = 1 share
+		{name: "buy at 0.5", feeRateBps: 100, side: BUY, makerAmount: 50_000_000, takerAmount: 100_000_000, expected: 1_000_000},
+		// sell 100 shares at 0.5, fee = 100 * 0.5 * 100 / 10000 = 0.5 USDC
+		{name: "sell at 0.5", feeRateBps: 100, side: SELL, makerAmount: 100_000_000, takerAmount: 50_000_000, expected: 500_000},
+		// buy 100 shares at 0.2, fee = 100 * 0.2 * 100 / (0.2 * 10000) = 1 share
+		{name: "buy at 0.2", feeRateBps: 100, side: BUY, makerAmount: 20_000_000, takerAmount: 100_000_000, expected: 1_000_000},
+		// buy 100 shares at 0.8, fee = 100 * 0.2 * 100 / (0.8 * 10000) = 0.25 share
+		{name: "buy at 0.8", feeRateBps: 100, side: BUY, makerAmount: 80_000_000, takerAmount: 100_000_000, expected: 250_000},
+		// sell 100 shares at 0.8, fee = 100 * 0.2 * 100 / 10000 = 0.2 USDC
+		{name: "sell at 0.8", feeRateBps: 100, side: SELL, makerAmount: 100_000_000, takerAmount: 80_000_000, expected: 200_000},
+		{name: "zero fee rate", feeRateBps: 0, side: BUY, makerAmount: 50_000_000, takerAmount: 100_000_000, expected: 0},
+		{name: "price above one", feeRateBps: 100, side: BUY, makerAmount: 200_000_000, takerAmount: 100_000_000, expected: 0},
+		{name: "zero amount", feeRateBps: 100, side: SELL, makerAmount: 0, takerAmount: 50_000_000, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fee := CalcFee(tt.feeRateBps, tt.side, big.NewInt(tt.makerAmount), big.NewInt(tt.takerAmount))
+			assert.Equal(t, big.NewInt(tt.expected).String(), fee.String())
+		})
+	}
+
+	assert.Equal(t, "0", CalcFee(100, BUY, nil, big.NewInt(1)).String())
+}
@@ -1,8 +1,25 @@
 package model
 
+// VerifyingContract selects the exchange contract an order is signed for.
+//
+// Both exchanges use the DefaultDomainName EIP712 domain name and DefaultDomainVersion version,
+// the NegRiskCtfExchange inherits it from the CTFExchange.
+// Their domains differ by the verifying contract address, so an order signed
+// for one exchange is not valid on the other.
 type VerifyingContract = int
 
 const (
+	// Standard CTF Exchange, for binary markets
 	CTFExchange VerifyingContract = iota
+
+	// Neg Risk CTF Exchange, for negative risk multi-outcome markets
 	NegRiskCTFExchange
 )
+
+const (
+	// EIP712 domain name of the Polymarket exchanges
+	DefaultDomainName = "Polymarket CTF Exchange"
+
+	// EIP712 domain version of the Polymarket exchanges
+	DefaultDomainVersion = "1"
+)
@@ -4,18 +4,20 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
-	polymarketcontracts "github.com/ivanzzeth/polymarket-go-contracts"
 )
 
 type OrderSignature = []byte
 
+// OrderHash is the EIP712 digest of an order, the CLOB uses its Hex() form,
+// 0x prefixed lowercase, as the order ID
 type OrderHash = common.Hash
 
 type OrderData struct {
 	// Maker of the order, i.e the source of funds for the order
 	Maker string
 
-	// Address of the order taker. The zero address is used to indicate a public order
+	// Address of the order taker. The zero address is used to indicate a public order.
+	// Optional, if it is not present the order is public
 	Taker string
 
 	// Token Id of the CTF ERC1155 asset to be bought or sold.
@@ -46,7 +48,78 @@ type OrderData struct {
 	Side Side
 
 	// Signature type used by the Order. Default value 'EOA'
-	SignatureType polymarketcontracts.SignatureType
+	SignatureType SignatureType
+}
+
+type MarketOrderData struct {
+	// Maker of the order, i.e the source of funds for the order
+	Maker string
+
+	// Address of the order taker. The zero address is used to indicate a public order
+	Taker string
+
+	// Token Id of the CTF ERC1155 asset to be bought or sold.
+	TokenId string
+
+	// Human readable amount of the order.
+	// If BUY, this is the amount of collateral (USDC) to spend
+	// If SELL, this is the amount of shares to sell
+	Amount string
+
+	// Price of the order, i.e the price of one share in collateral
+	Price string
+
+	// Fee rate, in basis points, charged to the order maker, charged on proceeds
+	FeeRateBps string
+
+	// Nonce used for onchain cancellations
+	Nonce string
+
+	// Signer of the order. Optional, if it is not present the signer is the maker of the order.
+	Signer string
+
+	// The side of the order, BUY or SELL
+	Side Side
+
+	// Signature type used by the Order. Default value 'EOA'
+	SignatureType SignatureType
+}
+
+type PriceOrderParams struct {
+	// Maker of the order, i.e the source of funds for the order
+	Maker string
+
+	// Address of the order taker. The zero address is used to indicate a public order
+	Taker string
+
+	// Token Id of the CTF ERC1155 asset to be bought or sold.
+	TokenId string
+
+	// Price of the order, i.e the price of one share in collateral.
+	// It is rounded to the tick size of the builder
+	Price string
+
+	// Human readable size of the order, i.e the number of shares to buy or sell
+	Size string
+
+	// Fee rate, in basis points, charged to the order maker, charged on proceeds
+	FeeRateBps string
+
+	// Nonce used for onchain cancellations
+	Nonce string
+
+	// Signer of the order. Optional, if it is not present the signer is the maker of the order.
+	Signer string
+
+	// Timestamp after which the order is expired.
+	// Optional, if it is not present the value is '0' (no expiration)
+	Expiration string
+
+	// The side of the order, BUY or SELL
+	Side Side
+
+	// Signature type used by the Order. Default value 'EOA'
+	SignatureType SignatureType
 }
 
 type Order struct {
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// CanonicalBytes returns a fixed-layout encoding of the order, suitable as a cache or storage key.
+// The fields are ABI encoded as 32 byte words in the order of the EIP712 Order type,
+// salt, maker, signer, taker, tokenId, makerAmount, takerAmount, expiration, nonce,
+// feeRateBps, side and signatureType, so the encoding is 384 bytes long and is the
+// struct hash preimage without the type hash. Nil big integers are encoded as zero.
+//
+// The layout follows the exchange contract and does not change between versions.
+func (o *Order) CanonicalBytes() []byte {
+	encoded := make([]byte, 0, 12*32)
+	encoded = append(encoded, canonicalWord(o.Salt)...)
+	encoded = append(encoded, common.LeftPadBytes(o.Maker.Bytes(), 32)...)
+	encoded = append(encoded, common.LeftPadBytes(o.Signer.Bytes(), 32)...)
+	encoded = append(encoded, common.LeftPadBytes(o.Taker.Bytes(), 32)...)
+	encoded = append(encoded, canonicalWord(o.TokenId)...)
+	encoded = append(encoded, canonicalWord(o.MakerAmount)...)
+	encoded = append(encoded, canonicalWord(o.TakerAmount)...)
+	encoded = append(encoded, canonicalWord(o.Expiration)...)
+	encoded = append(encoded, canonicalWord(o.Nonce)...)
+	encoded = append(encoded, canonicalWord(o.FeeRateBps)...)
+	encoded = append(encoded, canonicalWord(o.Side)...)
+	encoded = append(encoded, canonicalWord(o.SignatureType)...)
+	return encoded
+}
+
+// canonicalWord encodes x as an ABI uint256 word, out of range values wrap like in the EVM
+func canonicalWord(x *big.Int) []byte {
+	if x == nil {
+		return make([]byte, 32)
+	}
+	return math.U256Bytes(new(big.Int).Set(x))
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderCanonicalBytes(t *testing.T) {
+	newOrder := func() *Order {
+		return &Order{
+			Salt:          big.NewInt(479249096354),
+			Maker:         common.HexToAddress("0xaFB8270A801862270FebB3763505b136491e557b"),
+			Signer:        common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+			Taker:         common.HexToAddress("0x0000000000000000000000000000000000000000"),
+			TokenId:       big.NewInt(1234),
+			MakerAmount:   big.NewInt(50000000),
+			TakerAmount:   big.NewInt(100000000),
+			Expiration:    big.NewInt(0),
+			Nonce:         big.NewInt(0),
+			FeeRateBps:    big.NewInt(100),
+			Side:          BUY.Int(),
+			SignatureType: big.NewInt(2),
+		}
+	}
+
+	order := newOrder()
+	encoded := order.CanonicalBytes()
+	assert.Len(t, encoded, 384)
+	assert.Equal(t, common.LeftPadBytes(big.NewInt(479249096354).Bytes(), 32), encoded[:32])
+	assert.Equal(t, common.LeftPadBytes(order.Maker.Bytes(), 32), encoded[32:64])
+	assert.Equal(t, common.LeftPadBytes(big.NewInt(2).Bytes(), 32), encoded[352:])
+
+	// equal orders encode the same, whatever the big integers' internal representation
+	other := newOrder()
+	other.MakerAmount = new(big.Int).Mul(big.NewInt(5000), big.NewInt(10000))
+	other.Expiration = nil
+	other.Nonce = new(big.Int)
+	assert.Equal(t, encoded, other.CanonicalBytes())
+	assert.Equal(t, encoded, order.Clone().CanonicalBytes())
+
+	// changing any field changes the encoding
+	mutations := map[string]func(o *Order){
+		"salt":          func(o *Order) { o.Salt.SetInt64(1) },
+		"maker":         func(o *Order) { o.Maker = common.HexToAddress("0x1") },
+		"signer":        func(o *Order) { o.Signer = common.HexToAddress("0x1") },
+		"taker":         func(o *Order) { o.Taker = common.HexToAddress("0x1") },
+		"tokenId":       func(o *Order) { o.TokenId.SetInt64(1) },
+		"makerAmount":   func(o *Order) { o.MakerAmount.SetInt64(1) },
+		"takerAmount":   func(o *Order) { o.TakerAmount.SetInt64(1) },
+		"expiration":    func(o *Order) { o.Expiration.SetInt64(1) },
+		"nonce":         func(o *Order) { o.Nonce.SetInt64(1) },
+		"feeRateBps":    func(o *Order) { o.FeeRateBps.SetInt64(1) },
+		"side":          func(o *Order) { o.Side = SELL.Int() },
+		"signatureType": func(o *Order) { o.SignatureType.SetInt64(0) },
+	}
+	for name, mutate := range mutations {
+		mutated := newOrder()
+		mutate(mutated)
+		assert.NotEqual(t, encoded, mutated.CanonicalBytes(), name)
+	}
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Clone returns a deep copy of the order, its big integers are not shared with the original.
+// Nil big integers stay nil.
+func (o *Order) Clone() *Order {
+	if o == nil {
+		return nil
+	}
+
+	c := *o
+	c.Salt = cloneBigInt(o.Salt)
+	c.TokenId = cloneBigInt(o.TokenId)
+	c.MakerAmount = cloneBigInt(o.MakerAmount)
+	c.TakerAmount = cloneBigInt(o.TakerAmount)
+	c.Side = cloneBigInt(o.Side)
+	c.Expiration = cloneBigInt(o.Expiration)
+	c.Nonce = cloneBigInt(o.Nonce)
+	c.FeeRateBps = cloneBigInt(o.FeeRateBps)
+	c.SignatureType = cloneBigInt(o.SignatureType)
+	return &c
+}
+
+// Clone returns a deep copy of the signed order, including its signature
+func (o *SignedOrder) Clone() *SignedOrder {
+	if o == nil {
+		return nil
+	}
+
+	return &SignedOrder{
+		Order:     *o.Order.Clone(),
+		Signature: common.CopyBytes(o.Signature),
+		OrderHash: o.OrderHash,
+	}
+}
+
+// Clone returns a copy of the order data. Its fields are values, so the copy is
+// independent of the original, Clone reads better than dereferencing when deriving variants.
+func (d *OrderData) Clone() *OrderData {
+	if d == nil {
+		return nil
+	}
+
+	c := *d
+	return &c
+}
+
+func cloneBigInt(x *big.Int) *big.Int {
+	if x == nil {
+		return nil
+	}
+	return new(big.Int).Set(x)
+}
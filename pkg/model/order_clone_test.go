@@ -0,0 +1,86 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderClone(t *testing.T) {
+	order := &Order{
+		Salt:          big.NewInt(479249096354),
+		Maker:         common.HexToAddress("0xaFB8270A801862270FebB3763505b136491e557b"),
+		Signer:        common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+		TokenId:       big.NewInt(1234),
+		MakerAmount:   big.NewInt(50000000),
+		TakerAmount:   big.NewInt(100000000),
+		Expiration:    big.NewInt(0),
+		Nonce:         big.NewInt(0),
+		FeeRateBps:    big.NewInt(100),
+		Side:          BUY.Int(),
+		SignatureType: big.NewInt(2),
+	}
+
+	clone := order.Clone()
+	assert.Equal(t, order, clone)
+
+	// mutating the clone leaves the original untouched
+	clone.MakerAmount.SetInt64(1)
+	clone.TokenId.SetInt64(1)
+	clone.Salt.SetInt64(1)
+	clone.FeeRateBps.SetInt64(0)
+	clone.Maker = common.Address{}
+	assert.Equal(t, "50000000", order.MakerAmount.String())
+	assert.Equal(t, "1234", order.TokenId.String())
+	assert.Equal(t, "479249096354", order.Salt.String())
+	assert.Equal(t, "100", order.FeeRateBps.String())
+	assert.Equal(t, common.HexToAddress("0xaFB8270A801862270FebB3763505b136491e557b"), order.Maker)
+
+	// nil fields stay nil
+	order.Expiration = nil
+	assert.Nil(t, order.Clone().Expiration)
+
+	assert.Nil(t, (*Order)(nil).Clone())
+}
+
+func TestSignedOrderClone(t *testing.T) {
+	signedOrder := &SignedOrder{
+		Order: Order{
+			Salt:        big.NewInt(1),
+			MakerAmount: big.NewInt(50000000),
+		},
+		Signature: []byte{1, 2, 3},
+		OrderHash: common.HexToHash("0x5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b"),
+	}
+
+	clone := signedOrder.Clone()
+	assert.Equal(t, signedOrder, clone)
+
+	clone.Signature[0] = 9
+	clone.MakerAmount.SetInt64(1)
+	assert.Equal(t, []byte{1, 2, 3}, []byte(signedOrder.Signature))
+	assert.Equal(t, "50000000", signedOrder.MakerAmount.String())
+
+	assert.Nil(t, (*SignedOrder)(nil).Clone())
+}
+
+func TestOrderDataClone(t *testing.T) {
+	data := &OrderData{
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        BUY,
+	}
+
+	clone := data.Clone()
+	assert.Equal(t, data, clone)
+
+	clone.MakerAmount = "1"
+	clone.Side = SELL
+	assert.Equal(t, "50000000", data.MakerAmount)
+	assert.Equal(t, BUY, data.Side)
+
+	assert.Nil(t, (*OrderData)(nil).Clone())
+}
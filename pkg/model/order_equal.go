@@ -0,0 +1,35 @@
+package model
+
+import "math/big"
+
+// Equal reports whether both orders have the same field values, i.e the same hash
+// for a given exchange. Big integers are compared by value and a nil big integer
+// is equal to zero.
+func (o *Order) Equal(other *Order) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+
+	return bigIntEqual(o.Salt, other.Salt) &&
+		bigIntEqual(o.TokenId, other.TokenId) &&
+		bigIntEqual(o.MakerAmount, other.MakerAmount) &&
+		bigIntEqual(o.TakerAmount, other.TakerAmount) &&
+		bigIntEqual(o.Side, other.Side) &&
+		bigIntEqual(o.Expiration, other.Expiration) &&
+		bigIntEqual(o.Nonce, other.Nonce) &&
+		bigIntEqual(o.FeeRateBps, other.FeeRateBps) &&
+		bigIntEqual(o.SignatureType, other.SignatureType) &&
+		o.Maker == other.Maker &&
+		o.Taker == other.Taker &&
+		o.Signer == other.Signer
+}
+
+func bigIntEqual(a, b *big.Int) bool {
+	if a == nil {
+		return b == nil || b.Sign() == 0
+	}
+	if b == nil {
+		return a.Sign() == 0
+	}
+	return a.Cmp(b) == 0
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestOrder() *Order {
+	return &Order{
+		Salt:          big.NewInt(479249096354),
+		TokenId:       big.NewInt(1234),
+		MakerAmount:   big.NewInt(100000000),
+		TakerAmount:   big.NewInt(50000000),
+		Side:          big.NewInt(int64(BUY)),
+		Expiration:    big.NewInt(0),
+		Nonce:         big.NewInt(0),
+		FeeRateBps:    big.NewInt(100),
+		SignatureType: big.NewInt(0),
+		Maker:         common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+		Signer:        common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+	}
+}
+
+func TestOrderEqual(t *testing.T) {
+	a := newTestOrder()
+	b := newTestOrder()
+
+	// numerically equal but different pointers
+	assert.NotSame(t, a.Salt, b.Salt)
+	assert.True(t, a.Equal(b))
+	assert.True(t, b.Equal(a))
+
+	// same value, different representation
+	b.MakerAmount, _ = new(big.Int).SetString("0000100000000", 10)
+	assert.True(t, a.Equal(b))
+
+	// nil and zero
+	b.Expiration = nil
+	b.Nonce = new(big.Int)
+	assert.True(t, a.Equal(b))
+	assert.True(t, b.Equal(a))
+
+	b.Salt = nil
+	assert.False(t, a.Equal(b))
+
+	b = newTestOrder()
+	b.FeeRateBps = big.NewInt(101)
+	assert.False(t, a.Equal(b))
+
+	b = newTestOrder()
+	b.Taker = common.HexToAddress("0x1")
+	assert.False(t, a.Equal(b))
+
+	var nilOrder *Order
+	assert.False(t, a.Equal(nil))
+	assert.False(t, nilOrder.Equal(a))
+	assert.True(t, nilOrder.Equal(nil))
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// OrderExpectation holds the fields an order is expected to carry, e.g the values
+// a risk layer intended to submit. Nil fields are not checked.
+type OrderExpectation struct {
+	// Expected maker of the order
+	Maker *common.Address
+
+	// Expected Token Id of the CTF ERC1155 asset to be bought or sold
+	TokenId *big.Int
+
+	// Expected side of the order
+	Side *Side
+}
+
+// OrderMismatchError reports the first field of an order that differs from the expectation
+type OrderMismatchError struct {
+	Field    string
+	Expected string
+	Actual   string
+}
+
+func (e *OrderMismatchError) Error() string {
+	return fmt.Sprintf("%s: %s expected %s, got %s", ErrOrderMismatch, e.Field, e.Expected, e.Actual)
+}
+
+func (e *OrderMismatchError) Unwrap() error {
+	return ErrOrderMismatch
+}
+
+// Check compares the order against the expected fields, in the order maker, tokenId, side.
+//
+// @returns nil if all the expected fields match, otherwise an *OrderMismatchError naming the first mismatching field
+func (e OrderExpectation) Check(order *Order) error {
+	if e.Maker != nil && order.Maker != *e.Maker {
+		return &OrderMismatchError{Field: "maker", Expected: e.Maker.Hex(), Actual: order.Maker.Hex()}
+	}
+
+	if e.TokenId != nil && !bigIntEqual(order.TokenId, e.TokenId) {
+		return &OrderMismatchError{Field: "tokenId", Expected: e.TokenId.String(), Actual: bigIntToString(order.TokenId)}
+	}
+
+	if e.Side != nil && !bigIntEqual(order.Side, e.Side.Int()) {
+		actual, err := sideToString(order.Side)
+		if err != nil {
+			actual = bigIntToString(order.Side)
+		}
+		return &OrderMismatchError{Field: "side", Expected: e.Side.String(), Actual: actual}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderExpectationCheck(t *testing.T) {
+	maker := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	order := &Order{
+		Maker:   maker,
+		TokenId: big.NewInt(1234),
+		Side:    SELL.Int(),
+	}
+
+	otherMaker := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	sell, buy := SELL, BUY
+
+	assert.NoError(t, OrderExpectation{}.Check(order))
+	assert.NoError(t, OrderExpectation{Maker: &maker, TokenId: big.NewInt(1234), Side: &sell}.Check(order))
+
+	for _, tt := range []struct {
+		expected OrderExpectation
+		field    string
+		message  string
+	}{
+		{
+			OrderExpectation{Maker: &otherMaker, TokenId: big.NewInt(1)},
+			"maker",
+			"maker expected 0x70997970C51812dc3A010C7d01b50e0d17dc79C8, got 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		},
+		{
+			OrderExpectation{Maker: &maker, TokenId: big.NewInt(1)},
+			"tokenId",
+			"tokenId expected 1, got 1234",
+		},
+		{
+			OrderExpectation{Side: &buy},
+			"side",
+			"side expected BUY, got SELL",
+		},
+	} {
+		err := tt.expected.Check(order)
+		assert.ErrorIs(t, err, ErrOrderMismatch)
+
+		var mismatch *OrderMismatchError
+		if assert.ErrorAs(t, err, &mismatch) {
+			assert.Equal(t, tt.field, mismatch.Field)
+		}
+		assert.ErrorContains(t, err, tt.message)
+	}
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// OrderExplanation holds every value derived while building an order, without signing it,
+// e.g to compare them one by one against another client when a signature is rejected.
+type OrderExplanation struct {
+	// The order resolved from the order data, salt included
+	Order *Order
+
+	// EIP712 domain of the exchange the order is hashed for
+	DomainName        string
+	DomainVersion     string
+	ChainID           *big.Int
+	VerifyingContract common.Address
+
+	// hashStruct(EIP712Domain) of the exchange, as returned by its DOMAIN_SEPARATOR()
+	DomainSeparator common.Hash
+
+	// hashStruct(order), independent of the exchange
+	StructHash common.Hash
+
+	// keccak256("\x19\x01" ‖ DomainSeparator ‖ StructHash), the digest signed by the maker
+	// and the order ID used by the CLOB
+	OrderHash OrderHash
+
+	// The order object of the CLOB POST /order body, with an empty "0x" signature
+	Payload json.RawMessage
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ParseOrderHash parses an order ID, i.e the hex form of an order hash.
+// The 0x prefix is optional and the hex digits are case-insensitive,
+// but all the 32 bytes must be present, leading zeros included.
+func ParseOrderHash(s string) (OrderHash, error) {
+	digits := s
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X") {
+		digits = digits[2:]
+	}
+	if len(digits) != 2*common.HashLength {
+		return OrderHash{}, fmt.Errorf("%w: expected %d hex digits, got %d in %q", ErrInvalidOrderHash, 2*common.HashLength, len(digits), s)
+	}
+
+	b, err := hex.DecodeString(digits)
+	if err != nil {
+		return OrderHash{}, fmt.Errorf("%w: %q is not hex", ErrInvalidOrderHash, s)
+	}
+
+	return common.BytesToHash(b), nil
+}
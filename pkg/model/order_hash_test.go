@@ -0,0 +1,44 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOrderHash(t *testing.T) {
+	for _, orderId := range []string{
+		"0x5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b",
+		// leading zero bytes are kept
+		"0x0000a8f1e0b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c",
+		"0x0000000000000000000000000000000000000000000000000000000000000000",
+	} {
+		orderHash, err := ParseOrderHash(orderId)
+		assert.NoError(t, err)
+		assert.Equal(t, orderId, orderHash.Hex())
+	}
+
+	// the prefix is optional and the case is normalized
+	orderHash, err := ParseOrderHash("5306D37DFE4389797614839D1A2D16CC42CF6171096320D8496411999100504B")
+	assert.NoError(t, err)
+	assert.Equal(t, "0x5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b", orderHash.Hex())
+
+	orderHash, err = ParseOrderHash("0X5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b")
+	assert.NoError(t, err)
+	assert.Equal(t, "0x5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b", orderHash.Hex())
+
+	for _, orderId := range []string{
+		"",
+		"0x",
+		// zero padding is not implied
+		"0xa8f1e0b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c",
+		"0x5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b00",
+		"0x5306d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504g",
+		"0x0x06d37dfe4389797614839d1a2d16cc42cf6171096320d8496411999100504b",
+		strings.Repeat(" ", 66),
+	} {
+		_, err := ParseOrderHash(orderId)
+		assert.ErrorIs(t, err, ErrInvalidOrderHash, orderId)
+	}
+}
@@ -0,0 +1,173 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// signedOrderJSON is the order object of the CLOB POST /order body.
+// The CLOB rejects orders with missing fields, none of them is omitempty:
+// zero values such as a zero fee rate are always emitted.
+type signedOrderJSON struct {
+	Salt          json.Number `json:"salt"`
+	Maker         string      `json:"maker"`
+	Signer        string      `json:"signer"`
+	Taker         string      `json:"taker"`
+	TokenId       string      `json:"tokenId"`
+	MakerAmount   string      `json:"makerAmount"`
+	TakerAmount   string      `json:"takerAmount"`
+	Expiration    string      `json:"expiration"`
+	Nonce         string      `json:"nonce"`
+	FeeRateBps    string      `json:"feeRateBps"`
+	Side          string      `json:"side"`
+	SignatureType int64       `json:"signatureType"`
+	Signature     string      `json:"signature"`
+}
+
+// MarshalJSON encodes the signed order in the shape expected by the CLOB POST /order body.
+// The salt and signatureType are integers, the other big integers are decimal strings,
+// the side is "BUY" or "SELL" and the signature is 0x prefixed hex.
+// The order hash is not part of the payload.
+func (o SignedOrder) MarshalJSON() ([]byte, error) {
+	side, err := sideToString(o.Side)
+	if err != nil {
+		return nil, err
+	}
+
+	var signatureType int64
+	if o.SignatureType != nil {
+		signatureType = o.SignatureType.Int64()
+	}
+
+	return json.Marshal(signedOrderJSON{
+		Salt:          json.Number(bigIntToString(o.Salt)),
+		Maker:         o.Maker.Hex(),
+		Signer:        o.Signer.Hex(),
+		Taker:         o.Taker.Hex(),
+		TokenId:       bigIntToString(o.TokenId),
+		MakerAmount:   bigIntToString(o.MakerAmount),
+		TakerAmount:   bigIntToString(o.TakerAmount),
+		Expiration:    bigIntToString(o.Expiration),
+		Nonce:         bigIntToString(o.Nonce),
+		FeeRateBps:    bigIntToString(o.FeeRateBps),
+		Side:          side,
+		SignatureType: signatureType,
+		Signature:     hexutil.Encode(o.Signature),
+	})
+}
+
+// UnmarshalJSON decodes a signed order from the shape used by the CLOB API.
+func (o *SignedOrder) UnmarshalJSON(data []byte) error {
+	var raw signedOrderJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var order SignedOrder
+	var err error
+
+	for _, field := range []struct {
+		name  string
+		value string
+		dst   **big.Int
+	}{
+		{"salt", raw.Salt.String(), &order.Salt},
+		{"tokenId", raw.TokenId, &order.TokenId},
+		{"makerAmount", raw.MakerAmount, &order.MakerAmount},
+		{"takerAmount", raw.TakerAmount, &order.TakerAmount},
+		{"expiration", raw.Expiration, &order.Expiration},
+		{"nonce", raw.Nonce, &order.Nonce},
+		{"feeRateBps", raw.FeeRateBps, &order.FeeRateBps},
+	} {
+		var ok bool
+		if *field.dst, ok = new(big.Int).SetString(field.value, 10); !ok {
+			return fmt.Errorf("can't parse %s: %s as valid *big.Int", field.name, field.value)
+		}
+		if (*field.dst).Sign() < 0 || (*field.dst).Cmp(maxUint256) > 0 {
+			return fmt.Errorf("%s: %s is out of the uint256 range", field.name, field.value)
+		}
+	}
+
+	for _, field := range []struct {
+		name  string
+		value string
+		dst   *common.Address
+	}{
+		{"maker", raw.Maker, &order.Maker},
+		{"signer", raw.Signer, &order.Signer},
+		{"taker", raw.Taker, &order.Taker},
+	} {
+		if !common.IsHexAddress(field.value) {
+			return fmt.Errorf("can't parse %s: %s as valid address", field.name, field.value)
+		}
+		*field.dst = common.HexToAddress(field.value)
+	}
+
+	if order.Side, err = sideFromString(raw.Side); err != nil {
+		return err
+	}
+
+	if raw.SignatureType < 0 || raw.SignatureType > math.MaxUint8 {
+		return fmt.Errorf("signatureType: %d is out of the uint8 range", raw.SignatureType)
+	}
+	order.SignatureType = big.NewInt(raw.SignatureType)
+
+	if order.Signature, err = hexutil.Decode(raw.Signature); err != nil {
+		return fmt.Errorf("can't parse signature: %s as valid hex: %w", raw.Signature, err)
+	}
+
+	*o = order
+	return nil
+}
+
+// ParseSignedOrder decodes a signed order returned by the CLOB API, see UnmarshalJSON.
+// The order hash is not part of the payload, it is left empty and can be rebuilt
+// with the builder's BuildOrderHash.
+func ParseSignedOrder(data []byte) (*SignedOrder, error) {
+	var order SignedOrder
+	if err := json.Unmarshal(data, &order); err != nil {
+		return nil, fmt.Errorf("can't parse signed order: %w", err)
+	}
+	return &order, nil
+}
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+func bigIntToString(x *big.Int) string {
+	if x == nil {
+		return "0"
+	}
+	return x.String()
+}
+
+func sideToString(side *big.Int) (string, error) {
+	s, err := sideFromInt(side)
+	if err != nil {
+		return "", err
+	}
+	return s.String(), nil
+}
+
+// sideFromInt converts the onchain side of an order, a nil side being BUY
+func sideFromInt(side *big.Int) (Side, error) {
+	switch {
+	case side == nil || side.Cmp(BUY.Int()) == 0:
+		return BUY, nil
+	case side.Cmp(SELL.Int()) == 0:
+		return SELL, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrInvalidSide, side.String())
+}
+
+func sideFromString(s string) (*big.Int, error) {
+	side, err := ParseSide(s)
+	if err != nil {
+		return nil, err
+	}
+	return side.Int(), nil
+}
@@ -0,0 +1,195 @@
+package model
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignedOrderMarshalJSON(t *testing.T) {
+	golden, err := os.ReadFile("testdata/signed_order.json")
+	assert.NoError(t, err)
+
+	tokenId, _ := new(big.Int).SetString("71321045679252212594626385532706912750332728571942532289631379312455583992563", 10)
+	signedOrder := &SignedOrder{
+		Order: Order{
+			Salt:          big.NewInt(479249096354),
+			Maker:         common.HexToAddress("0xaFB8270A801862270FebB3763505b136491e557b"),
+			Signer:        common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+			Taker:         common.HexToAddress("0x0"),
+			TokenId:       tokenId,
+			MakerAmount:   big.NewInt(50000000),
+			TakerAmount:   big.NewInt(100000000),
+			Expiration:    big.NewInt(0),
+			Nonce:         big.NewInt(0),
+			FeeRateBps:    big.NewInt(0),
+			Side:          big.NewInt(int64(BUY)),
+			SignatureType: big.NewInt(2),
+		},
+		Signature: common.Hex2Bytes("302cd9abd0b5fcaa202a344437ec0b6660da984e24ae9ad915a592a90facf5a51bb8a873cd8d270f070217fea1986531d5eec66f1162a81f66e026db653bf7ce1c"),
+	}
+
+	data, err := json.Marshal(signedOrder)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(golden), string(data))
+
+	// value and pointer encode the same
+	valueData, err := json.Marshal(*signedOrder)
+	assert.NoError(t, err)
+	assert.Equal(t, data, valueData)
+
+	// large amounts are not emitted in scientific notation
+	signedOrder.MakerAmount, _ = new(big.Int).SetString("1000000000000000000000000", 10)
+	signedOrder.Side = big.NewInt(int64(SELL))
+	data, err = json.Marshal(signedOrder)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"makerAmount":"1000000000000000000000000"`)
+	assert.Contains(t, string(data), `"side":"SELL"`)
+
+	// invalid side
+	signedOrder.Side = big.NewInt(2)
+	_, err = json.Marshal(signedOrder)
+	assert.Error(t, err)
+
+	// nil big integers are encoded as zero
+	data, err = json.Marshal(&SignedOrder{})
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"salt":0`)
+	assert.Contains(t, string(data), `"expiration":"0"`)
+	assert.Contains(t, string(data), `"side":"BUY"`)
+}
+
+func TestSignedOrderMarshalJSONZeroFields(t *testing.T) {
+	golden, err := os.ReadFile("testdata/signed_order_zero.json")
+	assert.NoError(t, err)
+
+	// a zero fee EOA order, all the optional fields left to zero
+	signedOrder := &SignedOrder{
+		Order: Order{
+			Salt:          big.NewInt(479249096354),
+			Maker:         common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+			Signer:        common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+			TokenId:       big.NewInt(1234),
+			MakerAmount:   big.NewInt(50000000),
+			TakerAmount:   big.NewInt(100000000),
+			Expiration:    big.NewInt(0),
+			Nonce:         big.NewInt(0),
+			FeeRateBps:    big.NewInt(0),
+			Side:          BUY.Int(),
+			SignatureType: big.NewInt(0),
+		},
+		Signature: common.Hex2Bytes("302cd9abd0b5fcaa202a344437ec0b6660da984e24ae9ad915a592a90facf5a51bb8a873cd8d270f070217fea1986531d5eec66f1162a81f66e026db653bf7ce1c"),
+	}
+
+	data, err := json.Marshal(signedOrder)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(golden), string(data))
+	assert.Contains(t, string(data), `"feeRateBps":"0"`)
+
+	// every field required by the CLOB is present, whatever its value
+	required := []string{
+		"salt", "maker", "signer", "taker", "tokenId", "makerAmount", "takerAmount",
+		"expiration", "nonce", "feeRateBps", "side", "signatureType", "signature",
+	}
+	for _, order := range []*SignedOrder{signedOrder, {}} {
+		data, err := json.Marshal(order)
+		assert.NoError(t, err)
+
+		var fields map[string]json.RawMessage
+		assert.NoError(t, json.Unmarshal(data, &fields))
+		assert.Len(t, fields, len(required))
+		for _, key := range required {
+			assert.Contains(t, fields, key)
+		}
+	}
+
+	// no field of the payload can be dropped
+	payload := reflect.TypeOf(signedOrderJSON{})
+	for i := 0; i < payload.NumField(); i++ {
+		assert.NotContains(t, payload.Field(i).Tag.Get("json"), "omitempty", payload.Field(i).Name)
+	}
+}
+
+func TestSignedOrderUnmarshalJSON(t *testing.T) {
+	golden, err := os.ReadFile("testdata/signed_order.json")
+	assert.NoError(t, err)
+
+	var signedOrder SignedOrder
+	assert.NoError(t, json.Unmarshal(golden, &signedOrder))
+
+	assert.Equal(t, "479249096354", signedOrder.Salt.String())
+	assert.Equal(t, common.HexToAddress("0xaFB8270A801862270FebB3763505b136491e557b"), signedOrder.Maker)
+	assert.Equal(t, common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"), signedOrder.Signer)
+	assert.Equal(t, common.Address{}, signedOrder.Taker)
+	assert.Equal(t, "71321045679252212594626385532706912750332728571942532289631379312455583992563", signedOrder.TokenId.String())
+	assert.Equal(t, "50000000", signedOrder.MakerAmount.String())
+	assert.Equal(t, "100000000", signedOrder.TakerAmount.String())
+	assert.Equal(t, "0", signedOrder.Expiration.String())
+	assert.Equal(t, "0", signedOrder.Nonce.String())
+	assert.Equal(t, "0", signedOrder.FeeRateBps.String())
+	assert.Equal(t, "0", signedOrder.Side.String())
+	assert.Equal(t, "2", signedOrder.SignatureType.String())
+	assert.Equal(t, "302cd9abd0b5fcaa202a344437ec0b6660da984e24ae9ad915a592a90facf5a51bb8a873cd8d270f070217fea1986531d5eec66f1162a81f66e026db653bf7ce1c", common.Bytes2Hex(signedOrder.Signature))
+
+	// round trip
+	data, err := json.Marshal(&signedOrder)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(golden), string(data))
+
+	// malformed payloads
+	for _, payload := range []string{
+		`{"salt": 1, "maker": "0x0", "signer": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "taker": "0x0000000000000000000000000000000000000000", "tokenId": "1", "makerAmount": "1", "takerAmount": "1", "expiration": "0", "nonce": "0", "feeRateBps": "0", "side": "BUY", "signatureType": 0, "signature": "0x"}`,
+		`{"salt": 1, "maker": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "signer": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "taker": "0x0000000000000000000000000000000000000000", "tokenId": "1e5", "makerAmount": "1", "takerAmount": "1", "expiration": "0", "nonce": "0", "feeRateBps": "0", "side": "BUY", "signatureType": 0, "signature": "0x"}`,
+		`{"salt": 1, "maker": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "signer": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "taker": "0x0000000000000000000000000000000000000000", "tokenId": "1", "makerAmount": "1", "takerAmount": "1", "expiration": "0", "nonce": "0", "feeRateBps": "0", "side": "buy!", "signatureType": 0, "signature": "0x"}`,
+		`{"salt": 1, "maker": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "signer": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "taker": "0x0000000000000000000000000000000000000000", "tokenId": "1", "makerAmount": "1", "takerAmount": "1", "expiration": "0", "nonce": "0", "feeRateBps": "0", "side": "SELL", "signatureType": 0, "signature": "0xzz"}`,
+	} {
+		assert.Error(t, json.Unmarshal([]byte(payload), &signedOrder), payload)
+	}
+}
+
+func TestParseSignedOrder(t *testing.T) {
+	golden, err := os.ReadFile("testdata/signed_order.json")
+	assert.NoError(t, err)
+
+	signedOrder, err := ParseSignedOrder(golden)
+	assert.NoError(t, err)
+	assert.Equal(t, "479249096354", signedOrder.Salt.String())
+	assert.Equal(t, OrderHash{}, signedOrder.OrderHash)
+
+	// marshal then parse yields an equal order
+	data, err := json.Marshal(signedOrder)
+	assert.NoError(t, err)
+
+	parsed, err := ParseSignedOrder(data)
+	assert.NoError(t, err)
+	assert.True(t, signedOrder.Order.Equal(&parsed.Order))
+	assert.Equal(t, signedOrder.Signature, parsed.Signature)
+
+	// the salt may be sent as a string
+	parsed, err = ParseSignedOrder([]byte(strings.Replace(string(golden), `479249096354`, `"479249096354"`, 1)))
+	assert.NoError(t, err)
+	assert.Equal(t, "479249096354", parsed.Salt.String())
+
+	maxUint256 := "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+	parsed, err = ParseSignedOrder([]byte(strings.Replace(string(golden), `"makerAmount": "50000000"`, `"makerAmount": "`+maxUint256+`"`, 1)))
+	assert.NoError(t, err)
+	assert.Equal(t, maxUint256, parsed.MakerAmount.String())
+
+	for _, malformed := range []string{
+		// 2^256
+		strings.Replace(string(golden), `"makerAmount": "50000000"`, `"makerAmount": "115792089237316195423570985008687907853269984665640564039457584007913129639936"`, 1),
+		strings.Replace(string(golden), `"nonce": "0"`, `"nonce": "-1"`, 1),
+		strings.Replace(string(golden), `"signatureType": 2`, `"signatureType": 256`, 1),
+		strings.Replace(string(golden), `"signature": "0x302c`, `"signature": "0xz02c`, 1),
+		`not json`,
+	} {
+		_, err := ParseSignedOrder([]byte(malformed))
+		assert.Error(t, err, malformed)
+	}
+}
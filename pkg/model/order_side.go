@@ -1,8 +1,83 @@
 package model
 
-type Side = int
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Side of an order, encoded onchain as 0 for BUY and 1 for SELL
+type Side int
 
 const (
 	BUY Side = iota
 	SELL
 )
+
+// Buy and Sell are aliases of BUY and SELL
+const (
+	Buy  = BUY
+	Sell = SELL
+)
+
+// ParseSide parses "BUY" or "SELL", case insensitively
+func ParseSide(s string) (Side, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "BUY":
+		return BUY, nil
+	case "SELL":
+		return SELL, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrInvalidSide, s)
+}
+
+// Valid reports whether the side is BUY or SELL
+func (s Side) Valid() bool {
+	return s == BUY || s == SELL
+}
+
+// String returns "BUY" or "SELL", as used by the CLOB API
+func (s Side) String() string {
+	switch s {
+	case BUY:
+		return "BUY"
+	case SELL:
+		return "SELL"
+	}
+	return fmt.Sprintf("Side(%d)", int(s))
+}
+
+// Int returns the onchain representation of the side, as hashed in the order struct
+func (s Side) Int() *big.Int {
+	return big.NewInt(int64(s))
+}
+
+// MarshalText encodes the side as "BUY" or "SELL", so the JSON encoding matches the CLOB API
+func (s Side) MarshalText() ([]byte, error) {
+	if !s.Valid() {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidSide, int(s))
+	}
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes the side with ParseSide
+func (s *Side) UnmarshalText(text []byte) error {
+	side, err := ParseSide(string(text))
+	if err != nil {
+		return err
+	}
+	*s = side
+	return nil
+}
+
+// IsBuy reports whether the order is a BUY order, a nil side is BUY
+func (o *Order) IsBuy() bool {
+	side, err := sideFromInt(o.Side)
+	return err == nil && side == BUY
+}
+
+// IsSell reports whether the order is a SELL order
+func (o *Order) IsSell() bool {
+	side, err := sideFromInt(o.Side)
+	return err == nil && side == SELL
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSide(t *testing.T) {
+	for input, expected := range map[string]Side{
+		"BUY":    BUY,
+		"buy":    BUY,
+		"Buy":    BUY,
+		" bUy ":  BUY,
+		"SELL":   SELL,
+		"sell":   SELL,
+		"SeLl":   SELL,
+		"sell\n": SELL,
+	} {
+		side, err := ParseSide(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, side, input)
+	}
+
+	for _, input := range []string{"", "0", "B", "buyer", "bid"} {
+		_, err := ParseSide(input)
+		assert.ErrorIs(t, err, ErrInvalidSide, input)
+	}
+}
+
+func TestSideString(t *testing.T) {
+	assert.Equal(t, "BUY", BUY.String())
+	assert.Equal(t, "SELL", SELL.String())
+	assert.Equal(t, "Side(2)", Side(2).String())
+
+	assert.Equal(t, Buy, BUY)
+	assert.Equal(t, Sell, SELL)
+	assert.True(t, BUY.Valid())
+	assert.True(t, SELL.Valid())
+	assert.False(t, Side(2).Valid())
+	assert.False(t, Side(-1).Valid())
+}
+
+func TestSideInt(t *testing.T) {
+	assert.Equal(t, big.NewInt(0), BUY.Int())
+	assert.Equal(t, big.NewInt(1), SELL.Int())
+}
+
+func TestSideJSON(t *testing.T) {
+	type payload struct {
+		Side Side `json:"side"`
+	}
+
+	for _, side := range []Side{BUY, SELL} {
+		data, err := json.Marshal(payload{Side: side})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"side":"`+side.String()+`"}`, string(data))
+
+		var decoded payload
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, side, decoded.Side)
+	}
+
+	var decoded payload
+	assert.NoError(t, json.Unmarshal([]byte(`{"side":"sell"}`), &decoded))
+	assert.Equal(t, SELL, decoded.Side)
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"side":"hold"}`), &decoded), ErrInvalidSide)
+	assert.Error(t, json.Unmarshal([]byte(`{"side":1}`), &decoded))
+
+	_, err := json.Marshal(payload{Side: 2})
+	assert.ErrorIs(t, err, ErrInvalidSide)
+}
+
+func TestOrderIsBuyIsSell(t *testing.T) {
+	order := &Order{Side: BUY.Int()}
+	assert.True(t, order.IsBuy())
+	assert.False(t, order.IsSell())
+
+	order.Side = SELL.Int()
+	assert.False(t, order.IsBuy())
+	assert.True(t, order.IsSell())
+
+	// nil is BUY, as in the CLOB payload
+	order.Side = nil
+	assert.True(t, order.IsBuy())
+	assert.False(t, order.IsSell())
+
+	order.Side = big.NewInt(2)
+	assert.False(t, order.IsBuy())
+	assert.False(t, order.IsSell())
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// OrderType is the EIP712 encoding of the exchange's Order struct type
+const OrderType = "Order(uint256 salt,address maker,address signer,address taker,uint256 tokenId," +
+	"uint256 makerAmount,uint256 takerAmount,uint256 expiration,uint256 nonce,uint256 feeRateBps," +
+	"uint8 side,uint8 signatureType)"
+
+var orderTypeHash = crypto.Keccak256Hash([]byte(OrderType))
+
+// OrderTypeHash returns the EIP712 type hash of the Order struct, equal to the exchange's ORDER_TYPEHASH
+func OrderTypeHash() common.Hash {
+	return orderTypeHash
+}
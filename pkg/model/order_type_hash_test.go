@@ -0,0 +1,12 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderTypeHash(t *testing.T) {
+	// ORDER_TYPEHASH of the CTFExchange OrderStructs
+	assert.Equal(t, "0xa852566c4e14d00869b6db0220888a9090a13eccdaea03713ff0a3d27bf9767c", OrderTypeHash().Hex())
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Derives the order data replacing a previous order at a new price and size,
+// i.e the order placed after cancelling the previous one.
+//
+// The maker, signer, taker, tokenId, side, fee rate, expiration and signature type are preserved.
+// The salt is not carried over, the builder draws a fresh one so the replacement never
+// has the hash of the previous order. The nonce is preserved too: it is the maker's onchain
+// nonce, an order with another nonce is rejected by the exchange until incrementNonce is called.
+//
+// @param prev - the order to replace
+//
+// @param newPrice - the price of the replacement
+//
+// @param newSize - the size of the replacement, in shares
+//
+// @returns the order data of the replacement, to be built and signed by the builder
+func DeriveReplacement(prev *Order, newPrice, newSize decimal.Decimal) (*OrderData, error) {
+	return DefaultTokenDecimals.DeriveReplacement(prev, newPrice, newSize)
+}
+
+// Derives the order data replacing a previous order with these token decimals, see DeriveReplacement.
+func (d TokenDecimals) DeriveReplacement(prev *Order, newPrice, newSize decimal.Decimal) (*OrderData, error) {
+	if prev == nil {
+		return nil, fmt.Errorf("previous order is nil")
+	}
+	if prev.TokenId == nil {
+		return nil, fmt.Errorf("%w: tokenId", ErrMissingOrderField)
+	}
+
+	side, err := sideFromInt(prev.Side)
+	if err != nil {
+		return nil, err
+	}
+
+	makerAmount, takerAmount, err := d.CalcOrderAmounts(side, newPrice, newSize)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureType := SignatureType(0)
+	if prev.SignatureType != nil {
+		if !prev.SignatureType.IsUint64() || !SignatureType(prev.SignatureType.Uint64()).Valid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSignatureType, prev.SignatureType.String())
+		}
+		signatureType = SignatureType(prev.SignatureType.Uint64())
+	}
+
+	return &OrderData{
+		Maker:         prev.Maker.Hex(),
+		Taker:         prev.Taker.Hex(),
+		TokenId:       bigIntToString(prev.TokenId),
+		MakerAmount:   makerAmount.String(),
+		TakerAmount:   takerAmount.String(),
+		FeeRateBps:    bigIntToString(prev.FeeRateBps),
+		Nonce:         bigIntToString(prev.Nonce),
+		Signer:        prev.Signer.Hex(),
+		Expiration:    bigIntToString(prev.Expiration),
+		Side:          side,
+		SignatureType: signatureType,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeriveReplacement(t *testing.T) {
+	prev := &Order{
+		Salt:          big.NewInt(479249096354),
+		TokenId:       big.NewInt(1234),
+		MakerAmount:   big.NewInt(50000000),
+		TakerAmount:   big.NewInt(100000000),
+		Side:          BUY.Int(),
+		Expiration:    big.NewInt(1735689600),
+		Nonce:         big.NewInt(3),
+		FeeRateBps:    big.NewInt(100),
+		SignatureType: SignatureTypePolyGnosisSafe.Int(),
+		Maker:         common.HexToAddress("0xaFB8270A801862270FebB3763505b136491e557b"),
+		Taker:         common.Address{},
+		Signer:        common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+	}
+
+	replacement, err := DeriveReplacement(prev, decimal.RequireFromString("0.52"), decimal.RequireFromString("200"))
+	assert.NoError(t, err)
+	assert.Equal(t, &OrderData{
+		Maker:         prev.Maker.Hex(),
+		Taker:         prev.Taker.Hex(),
+		TokenId:       "1234",
+		MakerAmount:   "104000000",
+		TakerAmount:   "200000000",
+		FeeRateBps:    "100",
+		Nonce:         "3",
+		Signer:        prev.Signer.Hex(),
+		Expiration:    "1735689600",
+		Side:          BUY,
+		SignatureType: SignatureTypePolyGnosisSafe,
+	}, replacement)
+	assert.NoError(t, ValidateOrderData(replacement))
+
+	prev.Side = SELL.Int()
+	replacement, err = DeriveReplacement(prev, decimal.RequireFromString("0.52"), decimal.RequireFromString("200"))
+	assert.NoError(t, err)
+	assert.Equal(t, SELL, replacement.Side)
+	assert.Equal(t, "200000000", replacement.MakerAmount)
+	assert.Equal(t, "104000000", replacement.TakerAmount)
+
+	_, err = DeriveReplacement(prev, decimal.RequireFromString("1.2"), decimal.RequireFromString("200"))
+	assert.ErrorIs(t, err, ErrPriceOutOfRange)
+
+	_, err = DeriveReplacement(prev, decimal.RequireFromString("0.5"), decimal.Zero)
+	assert.ErrorIs(t, err, ErrZeroAmount)
+
+	prev.Side = big.NewInt(2)
+	_, err = DeriveReplacement(prev, decimal.RequireFromString("0.5"), decimal.RequireFromString("10"))
+	assert.ErrorIs(t, err, ErrInvalidSide)
+
+	prev.Side = BUY.Int()
+	prev.SignatureType = big.NewInt(7)
+	_, err = DeriveReplacement(prev, decimal.RequireFromString("0.5"), decimal.RequireFromString("10"))
+	assert.ErrorIs(t, err, ErrInvalidSignatureType)
+
+	_, err = DeriveReplacement(nil, decimal.RequireFromString("0.5"), decimal.RequireFromString("10"))
+	assert.Error(t, err)
+
+	// nil optional fields are zero, a nil token id is rejected
+	prev.SignatureType = nil
+	prev.FeeRateBps, prev.Nonce, prev.Expiration = nil, nil, nil
+	data, err := DeriveReplacement(prev, decimal.RequireFromString("0.5"), decimal.RequireFromString("10"))
+	assert.NoError(t, err)
+	assert.Equal(t, "0", data.FeeRateBps)
+	assert.Equal(t, "0", data.Nonce)
+	assert.Equal(t, "0", data.Expiration)
+	assert.Equal(t, SignatureTypeEOA, data.SignatureType)
+
+	prev.TokenId = nil
+	_, err = DeriveReplacement(prev, decimal.RequireFromString("0.5"), decimal.RequireFromString("10"))
+	assert.ErrorIs(t, err, ErrMissingOrderField)
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// NormalizeSignature returns the canonical low-S form of a 65 bytes [R || S || V] signature.
+// If s is in the upper half of the curve order it is replaced by n - s and the recovery id
+// is flipped, both signatures recover to the same address. The recovery id convention,
+// 0/1 or 27/28, is kept.
+func NormalizeSignature(signature []byte) ([]byte, error) {
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidSignatureLen, crypto.SignatureLength, len(signature))
+	}
+
+	v := signature[crypto.RecoveryIDOffset]
+	if v != 0 && v != 1 && v != 27 && v != 28 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidSignatureV, v)
+	}
+
+	sig := common.CopyBytes(signature)
+	s := new(big.Int).SetBytes(sig[32:64])
+	if s.Cmp(secp256k1HalfN) <= 0 {
+		return sig, nil
+	}
+
+	s.Sub(secp256k1N, s)
+	s.FillBytes(sig[32:64])
+	switch v {
+	case 0, 27:
+		sig[crypto.RecoveryIDOffset] = v + 1
+	case 1, 28:
+		sig[crypto.RecoveryIDOffset] = v - 1
+	}
+
+	return sig, nil
+}
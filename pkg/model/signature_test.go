@@ -0,0 +1,59 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSignature(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	assert.NoError(t, err)
+	hash := crypto.Keccak256Hash([]byte("hello"))
+
+	// go-ethereum always produces low-S signatures
+	lowS, err := crypto.Sign(hash[:], privateKey)
+	assert.NoError(t, err)
+
+	normalized, err := NormalizeSignature(lowS)
+	assert.NoError(t, err)
+	assert.Equal(t, lowS, normalized)
+
+	// malleated high-S signature: s' = n - s, v flipped
+	highS := common.CopyBytes(lowS)
+	s := new(big.Int).SetBytes(highS[32:64])
+	new(big.Int).Sub(crypto.S256().Params().N, s).FillBytes(highS[32:64])
+	highS[64] ^= 1
+	assert.True(t, new(big.Int).SetBytes(highS[32:64]).Cmp(secp256k1HalfN) > 0)
+
+	// both recover to the same key
+	lowPub, err := crypto.Ecrecover(hash[:], lowS)
+	assert.NoError(t, err)
+	highPub, err := crypto.Ecrecover(hash[:], highS)
+	assert.NoError(t, err)
+	assert.Equal(t, lowPub, highPub)
+
+	normalized, err = NormalizeSignature(highS)
+	assert.NoError(t, err)
+	assert.Equal(t, lowS, normalized)
+	// the input is not modified
+	assert.NotEqual(t, lowS, highS)
+
+	// the 27/28 convention is kept
+	highS[64] += 27
+	normalized, err = NormalizeSignature(highS)
+	assert.NoError(t, err)
+	assert.Equal(t, lowS[:64], normalized[:64])
+	assert.Equal(t, lowS[64]+27, normalized[64])
+
+	_, err = NormalizeSignature(lowS[:64])
+	assert.ErrorIs(t, err, ErrInvalidSignatureLen)
+
+	invalidV := common.CopyBytes(lowS)
+	invalidV[64] = 2
+	_, err = NormalizeSignature(invalidV)
+	assert.ErrorIs(t, err, ErrInvalidSignatureV)
+}
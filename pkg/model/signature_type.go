@@ -1,4 +1,57 @@
 package model
 
-// This file is deprecated. Use github.com/ivanzzeth/polymarket-go-contracts instead.
-// SignatureType definitions have been moved to the polymarket-go-contracts package.
+import (
+	"fmt"
+	"math/big"
+
+	polymarketcontracts "github.com/ivanzzeth/polymarket-go-contracts"
+)
+
+// SignatureType of an order, i.e how the exchange validates the order signature
+type SignatureType uint8
+
+const (
+	// EOA signature type, for orders signed by the maker's EOA
+	SignatureTypeEOA = SignatureType(polymarketcontracts.SignatureTypeEOA)
+
+	// POLY_PROXY signature type, for orders of a Polymarket proxy wallet signed by its owner
+	SignatureTypePolyProxy = SignatureType(polymarketcontracts.SignatureTypePolyProxy)
+
+	// POLY_GNOSIS_SAFE signature type, for orders of a Gnosis safe signed by its owner
+	SignatureTypePolyGnosisSafe = SignatureType(polymarketcontracts.SignatureTypePolyGnosisSafe)
+
+	// POLY_1271 signature type, for orders signed by a smart contract wallet.
+	// The wallet is both the maker and the signer of the order and the exchange validates
+	// the signature by calling the wallet's EIP-1271 isValidSignature(hash, signature),
+	// so the signature can't be verified locally by ECDSA recovery.
+	SignatureTypePoly1271 SignatureType = 3
+)
+
+// Valid reports whether the signature type is known to the exchange
+func (t SignatureType) Valid() bool {
+	switch t {
+	case SignatureTypeEOA, SignatureTypePolyProxy, SignatureTypePolyGnosisSafe, SignatureTypePoly1271:
+		return true
+	}
+	return false
+}
+
+// String returns the name of the signature type in the exchange's enum, e.g "POLY_PROXY"
+func (t SignatureType) String() string {
+	switch t {
+	case SignatureTypeEOA:
+		return "EOA"
+	case SignatureTypePolyProxy:
+		return "POLY_PROXY"
+	case SignatureTypePolyGnosisSafe:
+		return "POLY_GNOSIS_SAFE"
+	case SignatureTypePoly1271:
+		return "POLY_1271"
+	}
+	return fmt.Sprintf("SignatureType(%d)", uint8(t))
+}
+
+// Int returns the onchain representation of the signature type, as hashed in the order struct
+func (t SignatureType) Int() *big.Int {
+	return big.NewInt(int64(t))
+}
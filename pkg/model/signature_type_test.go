@@ -0,0 +1,46 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	polymarketcontracts "github.com/ivanzzeth/polymarket-go-contracts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignatureType(t *testing.T) {
+	for _, tt := range []struct {
+		signatureType SignatureType
+		value         int64
+		name          string
+	}{
+		{SignatureTypeEOA, 0, "EOA"},
+		{SignatureTypePolyProxy, 1, "POLY_PROXY"},
+		{SignatureTypePolyGnosisSafe, 2, "POLY_GNOSIS_SAFE"},
+		{SignatureTypePoly1271, 3, "POLY_1271"},
+	} {
+		assert.True(t, tt.signatureType.Valid(), tt.name)
+		assert.Equal(t, tt.name, tt.signatureType.String())
+		assert.Equal(t, big.NewInt(tt.value), tt.signatureType.Int())
+	}
+
+	// values of the exchange contracts' bindings
+	assert.Equal(t, SignatureTypeEOA, SignatureType(polymarketcontracts.SignatureTypeEOA))
+	assert.Equal(t, SignatureTypePolyProxy, SignatureType(polymarketcontracts.SignatureTypePolyProxy))
+	assert.Equal(t, SignatureTypePolyGnosisSafe, SignatureType(polymarketcontracts.SignatureTypePolyGnosisSafe))
+
+	invalid := SignatureType(4)
+	assert.False(t, invalid.Valid())
+	assert.Equal(t, "SignatureType(4)", invalid.String())
+
+	err := ValidateOrderData(&OrderData{
+		Maker:         "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		TokenId:       "1234",
+		MakerAmount:   "50",
+		TakerAmount:   "100",
+		FeeRateBps:    "0",
+		SignatureType: invalid,
+	})
+	assert.ErrorIs(t, err, ErrInvalidSignatureType)
+	assert.ErrorContains(t, err, "SignatureType(4)")
+}
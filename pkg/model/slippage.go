@@ -0,0 +1,38 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// CheckSlippage guards an order against crossing at a worse price than worstPrice,
+// with Polymarket's token decimals. A BUY order's implied price must not be above worstPrice,
+// a SELL order's must not be below it.
+//
+// @param order
+//
+// @param worstPrice - the worst acceptable price of one share in collateral
+//
+// @returns nil if the order is within the limit, otherwise an error wrapping ErrSlippageExceeded
+func CheckSlippage(order *Order, worstPrice decimal.Decimal) error {
+	return DefaultTokenDecimals.CheckSlippage(order, worstPrice)
+}
+
+// CheckSlippage guards an order against crossing at a worse price than worstPrice
+// with these token decimals, see CheckSlippage.
+func (d TokenDecimals) CheckSlippage(order *Order, worstPrice decimal.Decimal) error {
+	side, err := sideFromInt(order.Side)
+	if err != nil {
+		return err
+	}
+
+	price := d.OrderPrice(order)
+	switch {
+	case side == BUY && price.GreaterThan(worstPrice):
+		return fmt.Errorf("%w: BUY price %s above %s", ErrSlippageExceeded, price.String(), worstPrice.String())
+	case side == SELL && price.LessThan(worstPrice):
+		return fmt.Errorf("%w: SELL price %s below %s", ErrSlippageExceeded, price.String(), worstPrice.String())
+	}
+	return nil
+}
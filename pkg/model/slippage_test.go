@@ -0,0 +1,53 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSlippage(t *testing.T) {
+	order := func(side Side, price string) *Order {
+		makerAmount, takerAmount, err := CalcOrderAmounts(side, decimal.RequireFromString(price), decimal.RequireFromString("100"))
+		assert.NoError(t, err)
+		return &Order{MakerAmount: makerAmount, TakerAmount: takerAmount, Side: side.Int()}
+	}
+
+	for _, tc := range []struct {
+		side       Side
+		price      string
+		worstPrice string
+		exceeded   bool
+	}{
+		// a buy price must not be above the worst price
+		{BUY, "0.52", "0.55", false},
+		{BUY, "0.55", "0.55", false},
+		{BUY, "0.56", "0.55", true},
+		// a sell price must not be below it
+		{SELL, "0.52", "0.50", false},
+		{SELL, "0.50", "0.50", false},
+		{SELL, "0.49", "0.50", true},
+	} {
+		err := CheckSlippage(order(tc.side, tc.price), decimal.RequireFromString(tc.worstPrice))
+		if tc.exceeded {
+			assert.ErrorIs(t, err, ErrSlippageExceeded, "%s at %s, worst %s", tc.side, tc.price, tc.worstPrice)
+		} else {
+			assert.NoError(t, err, "%s at %s, worst %s", tc.side, tc.price, tc.worstPrice)
+		}
+	}
+
+	// the implied price uses the token decimals
+	decimals := TokenDecimals{Collateral: 18, ConditionalToken: 6}
+	makerAmount, takerAmount, err := decimals.CalcOrderAmounts(BUY, decimal.RequireFromString("0.52"), decimal.RequireFromString("100"))
+	assert.NoError(t, err)
+	buy := &Order{MakerAmount: makerAmount, TakerAmount: takerAmount, Side: BUY.Int()}
+	assert.NoError(t, decimals.CheckSlippage(buy, decimal.RequireFromString("0.52")))
+	assert.ErrorIs(t, decimals.CheckSlippage(buy, decimal.RequireFromString("0.51")), ErrSlippageExceeded)
+
+	// invalid side
+	invalid := order(BUY, "0.5")
+	invalid.Side = big.NewInt(2)
+	assert.ErrorIs(t, CheckSlippage(invalid, decimal.RequireFromString("0.5")), ErrInvalidSide)
+}
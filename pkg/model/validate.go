@@ -0,0 +1,115 @@
+package model
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+)
+
+// Maximum fee rate, in basis points, accepted by the exchange contracts (10%).
+// Markets may charge less, see ChainConfig.MaxFeeRateBps and TokenDecimals.ValidateOrderDataMaxFee.
+const MaxFeeRateBps = 1000
+
+// Checks the invariants of the order data before it is built and signed:
+// the token id is a uint256, the amounts are valid, the price implied by the amounts is in (0, 1),
+// the side and the signature type are known, the fee rate is within [0, MaxFeeRateBps], a non-zero taker is a valid address
+// and the signer is consistent with the signature type,
+// i.e it differs from the maker for POLY_PROXY and POLY_GNOSIS_SAFE and equals it for POLY_1271.
+//
+// @param data
+//
+// @returns nil if the order data is valid, otherwise an error wrapping one of the sentinel errors
+// when the failure has one
+func ValidateOrderData(data *OrderData) error {
+	return DefaultTokenDecimals.ValidateOrderData(data)
+}
+
+// Checks the invariants of the order data with these token decimals, see ValidateOrderData.
+func (d TokenDecimals) ValidateOrderData(data *OrderData) error {
+	return d.ValidateOrderDataMaxFee(data, MaxFeeRateBps)
+}
+
+// Checks the invariants of the order data with these token decimals and the fee rate capped
+// at maxFeeRateBps, e.g the max fee of the market, see ValidateOrderData.
+// A fee rate above the cap returns ErrFeeTooHigh.
+func (d TokenDecimals) ValidateOrderDataMaxFee(data *OrderData, maxFeeRateBps int64) error {
+	if data == nil {
+		return fmt.Errorf("order data is nil")
+	}
+
+	// Position ids are uint256 decimal strings, a hex id doesn't parse
+	tokenId, ok := new(big.Int).SetString(data.TokenId, 10)
+	if !ok {
+		return fmt.Errorf("%w: can't parse TokenId: %s as valid *big.Int", ErrInvalidTokenId, data.TokenId)
+	}
+	if tokenId.Sign() < 0 || tokenId.Cmp(maxUint256) > 0 {
+		return fmt.Errorf("%w: %s not in [0, 2^256)", ErrInvalidTokenId, tokenId.String())
+	}
+
+	makerAmount, ok := new(big.Int).SetString(data.MakerAmount, 10)
+	if !ok {
+		return fmt.Errorf("can't parse MakerAmount: %s as valid *big.Int", data.MakerAmount)
+	}
+
+	takerAmount, ok := new(big.Int).SetString(data.TakerAmount, 10)
+	if !ok {
+		return fmt.Errorf("can't parse TakerAmount: %s as valid *big.Int", data.TakerAmount)
+	}
+
+	if makerAmount.Sign() <= 0 || takerAmount.Sign() <= 0 {
+		return fmt.Errorf("%w: makerAmount %s, takerAmount %s", ErrZeroAmount, makerAmount.String(), takerAmount.String())
+	}
+
+	if !data.Side.Valid() {
+		return fmt.Errorf("%w: %d", ErrInvalidSide, data.Side)
+	}
+
+	// Prices are probabilities, a unit mistake in the amounts shows as a price out of range
+	if price := d.ImpliedPrice(data.Side, makerAmount, takerAmount); !price.IsPositive() || !price.LessThan(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w: %s side amounts makerAmount %s, takerAmount %s imply price %s not in (0, 1)",
+			ErrPriceOutOfRange, data.Side, makerAmount.String(), takerAmount.String(), price.String())
+	}
+
+	if !data.SignatureType.Valid() {
+		return fmt.Errorf("%w: %s", ErrInvalidSignatureType, data.SignatureType)
+	}
+
+	feeRateBps, ok := new(big.Int).SetString(data.FeeRateBps, 10)
+	if !ok {
+		return fmt.Errorf("can't parse FeeRateBps: %s as valid *big.Int", data.FeeRateBps)
+	}
+	if feeRateBps.Sign() < 0 {
+		return fmt.Errorf("%w: %s is negative", ErrInvalidFeeRate, feeRateBps.String())
+	}
+	// ErrFeeTooHigh is also an ErrInvalidFeeRate
+	if feeRateBps.Cmp(big.NewInt(maxFeeRateBps)) > 0 {
+		return fmt.Errorf("%w: %w: %s above %d", ErrInvalidFeeRate, ErrFeeTooHigh, feeRateBps.String(), maxFeeRateBps)
+	}
+
+	// An empty taker is the zero address, i.e a public order
+	if data.Taker != "" && !common.IsHexAddress(data.Taker) {
+		return fmt.Errorf("%w: %s is not a valid 20 bytes address", ErrInvalidTaker, data.Taker)
+	}
+
+	maker := common.HexToAddress(data.Maker)
+	signer := maker
+	if data.Signer != "" {
+		signer = common.HexToAddress(data.Signer)
+	}
+	switch data.SignatureType {
+	case SignatureTypePolyProxy, SignatureTypePolyGnosisSafe:
+		// Proxy wallets hold the funds while the EOA signs the order
+		if maker == signer {
+			return fmt.Errorf("%w: maker and signer must differ for signature type %s", ErrInvalidSigner, data.SignatureType)
+		}
+	case SignatureTypePoly1271:
+		// The contract wallet holds the funds and validates the signature
+		if maker != signer {
+			return fmt.Errorf("%w: maker and signer must be the same wallet for signature type %s", ErrInvalidSigner, data.SignatureType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package model
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOrderData(t *testing.T) {
+	validOrderData := func() *OrderData {
+		return &OrderData{
+			Maker:       "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        BUY,
+			FeeRateBps:  "100",
+			Nonce:       "0",
+		}
+	}
+
+	assert.NoError(t, ValidateOrderData(validOrderData()))
+
+	validTests := []struct {
+		name   string
+		modify func(*OrderData)
+	}{
+		{name: "sell", modify: func(o *OrderData) { o.Side, o.MakerAmount, o.TakerAmount = SELL, "100000000", "50000000" }},
+		{name: "zero fee", modify: func(o *OrderData) { o.FeeRateBps = "0" }},
+		{name: "zero token id", modify: func(o *OrderData) { o.TokenId = "0" }},
+		{name: "max token id", modify: func(o *OrderData) { o.TokenId = maxUint256.String() }},
+		{name: "max fee", modify: func(o *OrderData) { o.FeeRateBps = "1000" }},
+		{name: "zero taker", modify: func(o *OrderData) { o.Taker = "0x0000000000000000000000000000000000000000" }},
+		{name: "private taker", modify: func(o *OrderData) { o.Taker = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8" }},
+		{
+			name: "proxy",
+			modify: func(o *OrderData) {
+				o.Signer = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+				o.SignatureType = SignatureTypePolyProxy
+			},
+		},
+		{
+			name: "gnosis safe",
+			modify: func(o *OrderData) {
+				o.Signer = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+				o.SignatureType = SignatureTypePolyGnosisSafe
+			},
+		},
+		{
+			name: "contract wallet",
+			modify: func(o *OrderData) {
+				o.SignatureType = SignatureTypePoly1271
+			},
+		},
+	}
+
+	for _, tt := range validTests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderData := validOrderData()
+			tt.modify(orderData)
+			assert.NoError(t, ValidateOrderData(orderData))
+		})
+	}
+
+	invalidTests := []struct {
+		name     string
+		modify   func(*OrderData)
+		expected error
+	}{
+		{name: "zero maker amount", modify: func(o *OrderData) { o.MakerAmount = "0" }, expected: ErrZeroAmount},
+		{name: "negative token id", modify: func(o *OrderData) { o.TokenId = big.NewInt(-1).String() }, expected: ErrInvalidTokenId},
+		{name: "token id at 2^256", modify: func(o *OrderData) { o.TokenId = new(big.Int).Lsh(big.NewInt(1), 256).String() }, expected: ErrInvalidTokenId},
+		{name: "hex token id", modify: func(o *OrderData) { o.TokenId = "0x1234" }, expected: ErrInvalidTokenId},
+		{name: "negative taker amount", modify: func(o *OrderData) { o.TakerAmount = "-1" }, expected: ErrZeroAmount},
+		{name: "invalid side", modify: func(o *OrderData) { o.Side = 2 }, expected: ErrInvalidSide},
+		{name: "short taker", modify: func(o *OrderData) { o.Taker = "0x1" }, expected: ErrInvalidTaker},
+		{name: "malformed taker", modify: func(o *OrderData) { o.Taker = "0x70997970C51812dc3A010C7d01b50e0d17dc79CZ" }, expected: ErrInvalidTaker},
+		{name: "non-hex taker", modify: func(o *OrderData) { o.Taker = "zzzz" }, expected: ErrInvalidTaker},
+		{name: "empty hex taker", modify: func(o *OrderData) { o.Taker = "0x" }, expected: ErrInvalidTaker},
+		{name: "zero taker shorthand", modify: func(o *OrderData) { o.Taker = "0x0" }, expected: ErrInvalidTaker},
+		{name: "invalid signature type", modify: func(o *OrderData) { o.SignatureType = 4 }, expected: ErrInvalidSignatureType},
+		{name: "negative fee", modify: func(o *OrderData) { o.FeeRateBps = "-1" }, expected: ErrInvalidFeeRate},
+		{name: "fee over max", modify: func(o *OrderData) { o.FeeRateBps = "1001" }, expected: ErrInvalidFeeRate},
+		{name: "fee too high", modify: func(o *OrderData) { o.FeeRateBps = "1001" }, expected: ErrFeeTooHigh},
+		{
+			name: "proxy signed by maker",
+			modify: func(o *OrderData) {
+				o.SignatureType = SignatureTypePolyProxy
+			},
+			expected: ErrInvalidSigner,
+		},
+		{
+			name: "gnosis safe signed by maker",
+			modify: func(o *OrderData) {
+				o.Signer = o.Maker
+				o.SignatureType = SignatureTypePolyGnosisSafe
+			},
+			expected: ErrInvalidSigner,
+		},
+		{
+			name: "contract wallet with another signer",
+			modify: func(o *OrderData) {
+				o.Signer = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+				o.SignatureType = SignatureTypePoly1271
+			},
+			expected: ErrInvalidSigner,
+		},
+	}
+
+	for _, tt := range invalidTests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderData := validOrderData()
+			tt.modify(orderData)
+			assert.ErrorIs(t, ValidateOrderData(orderData), tt.expected)
+		})
+	}
+
+	// unparsable fields
+	for _, modify := range []func(*OrderData){
+		func(o *OrderData) { o.TokenId = "" },
+		func(o *OrderData) { o.TokenId = "0x1234" },
+		func(o *OrderData) { o.MakerAmount = "1.5" },
+		func(o *OrderData) { o.TakerAmount = "" },
+		func(o *OrderData) { o.FeeRateBps = "" },
+	} {
+		orderData := validOrderData()
+		modify(orderData)
+		assert.Error(t, ValidateOrderData(orderData))
+	}
+
+	assert.Error(t, ValidateOrderData(nil))
+}
+
+func TestValidateOrderDataMaxFee(t *testing.T) {
+	orderData := func(feeRateBps string) *OrderData {
+		return &OrderData{
+			Maker:       "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			TokenId:     "1234",
+			MakerAmount: "50000000",
+			TakerAmount: "100000000",
+			Side:        BUY,
+			FeeRateBps:  feeRateBps,
+			Nonce:       "0",
+		}
+	}
+
+	// at the cap of the market
+	assert.NoError(t, DefaultTokenDecimals.ValidateOrderDataMaxFee(orderData("200"), 200))
+	assert.NoError(t, DefaultTokenDecimals.ValidateOrderDataMaxFee(orderData("0"), 0))
+
+	// over it
+	err := DefaultTokenDecimals.ValidateOrderDataMaxFee(orderData("201"), 200)
+	assert.ErrorIs(t, err, ErrFeeTooHigh)
+	assert.ErrorIs(t, err, ErrInvalidFeeRate)
+	assert.ErrorIs(t, DefaultTokenDecimals.ValidateOrderDataMaxFee(orderData("1"), 0), ErrFeeTooHigh)
+
+	// a negative fee is invalid but not too high
+	err = DefaultTokenDecimals.ValidateOrderDataMaxFee(orderData("-1"), 200)
+	assert.ErrorIs(t, err, ErrInvalidFeeRate)
+	assert.NotErrorIs(t, err, ErrFeeTooHigh)
+}
+
+func TestValidateOrderDataImpliedPrice(t *testing.T) {
+	orderData := func(side Side, makerAmount, takerAmount string) *OrderData {
+		return &OrderData{
+			Maker:       "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			TokenId:     "1234",
+			MakerAmount: makerAmount,
+			TakerAmount: takerAmount,
+			Side:        side,
+			FeeRateBps:  "0",
+			Nonce:       "0",
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		side        Side
+		makerAmount string
+		takerAmount string
+		valid       bool
+	}{
+		// BUY: price = makerAmount / takerAmount
+		{"buy at 0.5", BUY, "50000000", "100000000", true},
+		{"buy just below 1", BUY, "999999", "1000000", true},
+		{"buy at 1", BUY, "1000000", "1000000", false},
+		{"buy above 1", BUY, "1000001", "1000000", false},
+		{"buy just above 0", BUY, "1", "1000000000000", true},
+		{"buy with inverted amounts", BUY, "100000000", "50000000", false},
+		// SELL: price = takerAmount / makerAmount
+		{"sell at 0.5", SELL, "100000000", "50000000", true},
+		{"sell just below 1", SELL, "1000000", "999999", true},
+		{"sell at 1", SELL, "1000000", "1000000", false},
+		{"sell above 1", SELL, "1000000", "1000001", false},
+		{"sell just above 0", SELL, "1000000000000", "1", true},
+		{"sell with inverted amounts", SELL, "50000000", "100000000", false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrderData(orderData(tt.side, tt.makerAmount, tt.takerAmount))
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrPriceOutOfRange)
+			}
+		})
+	}
+
+	// the implied price depends on the token decimals
+	decimals := TokenDecimals{Collateral: 6, ConditionalToken: 18}
+	assert.NoError(t, decimals.ValidateOrderData(orderData(BUY, "500000", "1000000000000000000")))
+	assert.ErrorIs(t, decimals.ValidateOrderData(orderData(BUY, "500000", "1000000")), ErrPriceOutOfRange)
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// Polymarket's proxy wallet factory on Polygon mainnet
+	PolyProxyFactoryAddress = common.HexToAddress("0xaB45c5A4B0c941a2F231C04C3f49182e1A254052")
+
+	// Hash of the init code of the proxy wallets deployed by the factory
+	PolyProxyInitCodeHash = common.HexToHash("0xd21df8dc65880a8606f09fe0ce3df9b8869287ab0b058be05aa9e8af6330a00b")
+
+	// Polymarket's gnosis safe factory on Polygon mainnet
+	GnosisSafeFactoryAddress = common.HexToAddress("0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b")
+
+	// Hash of the init code of the safes deployed by the factory, i.e the safe proxy bytecode and the singleton
+	GnosisSafeInitCodeHash = common.HexToHash("0x2bce2127ff07fb632d16c8347c4ebf501f4841168bed00d9e6ef715ddb6fcecf")
+)
+
+// Derives the POLY_PROXY wallet of the owner on Polygon mainnet.
+// The factory deploys the wallet with CREATE2 and a salt of keccak256(abi.encodePacked(owner)),
+// so the address is known before the wallet is deployed.
+func DerivePolyProxyAddress(owner common.Address) common.Address {
+	return derivePolyProxyAddress(PolyProxyFactoryAddress, owner)
+}
+
+// Derives the POLY_GNOSIS_SAFE wallet of the owner on Polygon mainnet.
+// The factory deploys the safe with CREATE2 and a salt of keccak256(abi.encode(owner)),
+// so the address is known before the safe is deployed.
+func DeriveGnosisSafeAddress(owner common.Address) common.Address {
+	return deriveGnosisSafeAddress(GnosisSafeFactoryAddress, owner)
+}
+
+// Derives the wallet of the owner holding the funds of the orders with the signature type,
+// from the factories of the chain.
+//
+// @returns the proxy wallet for POLY_PROXY, the safe for POLY_GNOSIS_SAFE
+func (c *ChainConfig) DeriveWalletAddress(signatureType SignatureType, owner common.Address) (common.Address, error) {
+	switch signatureType {
+	case SignatureTypePolyProxy:
+		if c.ProxyFactoryAddress == (common.Address{}) {
+			return common.Address{}, fmt.Errorf("no proxy wallet factory configured on chain %v", c.ChainID)
+		}
+		return derivePolyProxyAddress(c.ProxyFactoryAddress, owner), nil
+	case SignatureTypePolyGnosisSafe:
+		if c.SafeFactoryAddress == (common.Address{}) {
+			return common.Address{}, fmt.Errorf("no safe factory configured on chain %v", c.ChainID)
+		}
+		return deriveGnosisSafeAddress(c.SafeFactoryAddress, owner), nil
+	}
+
+	return common.Address{}, fmt.Errorf("%w: %s has no derived wallet", ErrInvalidSignatureType, signatureType)
+}
+
+func derivePolyProxyAddress(factory, owner common.Address) common.Address {
+	return crypto.CreateAddress2(factory, crypto.Keccak256Hash(owner.Bytes()), PolyProxyInitCodeHash.Bytes())
+}
+
+func deriveGnosisSafeAddress(factory, owner common.Address) common.Address {
+	return crypto.CreateAddress2(factory, crypto.Keccak256Hash(common.LeftPadBytes(owner.Bytes(), 32)), GnosisSafeInitCodeHash.Bytes())
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerivePolyProxyAddress(t *testing.T) {
+	owner := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+	// keccak256(0xff ++ factory ++ keccak256(owner) ++ initCodeHash)[12:]
+	data := append([]byte{0xff}, PolyProxyFactoryAddress.Bytes()...)
+	data = append(data, crypto.Keccak256(owner.Bytes())...)
+	data = append(data, PolyProxyInitCodeHash.Bytes()...)
+	expected := common.BytesToAddress(crypto.Keccak256(data)[12:])
+
+	proxy := DerivePolyProxyAddress(owner)
+	assert.Equal(t, expected, proxy)
+	assert.Equal(t, common.HexToAddress("0x365f0CA36Ae1f641E02fE3B7743673da42A13A70"), proxy)
+
+	// one wallet per owner
+	assert.NotEqual(t, proxy, DerivePolyProxyAddress(common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")))
+}
+
+func TestDeriveGnosisSafeAddress(t *testing.T) {
+	owner := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+	// keccak256(0xff ++ factory ++ keccak256(abi.encode(owner)) ++ initCodeHash)[12:]
+	data := append([]byte{0xff}, GnosisSafeFactoryAddress.Bytes()...)
+	data = append(data, crypto.Keccak256(common.LeftPadBytes(owner.Bytes(), 32))...)
+	data = append(data, GnosisSafeInitCodeHash.Bytes()...)
+	expected := common.BytesToAddress(crypto.Keccak256(data)[12:])
+
+	safe := DeriveGnosisSafeAddress(owner)
+	assert.Equal(t, expected, safe)
+	assert.Equal(t, common.HexToAddress("0xd93B25cb943D14d0d34FBaF01Fc93a0f8b5F6E47"), safe)
+
+	// the safe and the proxy wallet of an owner differ
+	assert.NotEqual(t, DerivePolyProxyAddress(owner), safe)
+}
+
+func TestChainConfigDeriveWalletAddress(t *testing.T) {
+	owner := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+	mainnet := MainnetChainConfig()
+	proxy, err := mainnet.DeriveWalletAddress(SignatureTypePolyProxy, owner)
+	assert.NoError(t, err)
+	assert.Equal(t, DerivePolyProxyAddress(owner), proxy)
+
+	safe, err := mainnet.DeriveWalletAddress(SignatureTypePolyGnosisSafe, owner)
+	assert.NoError(t, err)
+	assert.Equal(t, DeriveGnosisSafeAddress(owner), safe)
+
+	_, err = mainnet.DeriveWalletAddress(SignatureTypeEOA, owner)
+	assert.ErrorIs(t, err, ErrInvalidSignatureType)
+
+	// amoy has no proxy wallet factory
+	amoy := AmoyChainConfig()
+	_, err = amoy.DeriveWalletAddress(SignatureTypePolyProxy, owner)
+	assert.Error(t, err)
+
+	safe, err = amoy.DeriveWalletAddress(SignatureTypePolyGnosisSafe, owner)
+	assert.NoError(t, err)
+	assert.Equal(t, DeriveGnosisSafeAddress(owner), safe)
+}
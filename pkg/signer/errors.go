@@ -0,0 +1,12 @@
+package signer
+
+import "errors"
+
+var (
+	ErrAccountNotFound = errors.New("account not found in keystore")
+	ErrWrongPassphrase = errors.New("wrong keystore passphrase")
+	ErrSignerClosed    = errors.New("signer is closed")
+	ErrDeviceNotFound  = errors.New("no hardware wallet found")
+	ErrDeviceRejected  = errors.New("signing rejected on the hardware wallet")
+	ErrNoSigners       = errors.New("no signers to rotate across")
+)
@@ -0,0 +1,107 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ivanzzeth/ethsig/eip712"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+)
+
+// SignFunc signs the 32 bytes digest and returns the 65 bytes [R || S || V] signature
+type SignFunc func(hash []byte) ([]byte, error)
+
+// SignContextFunc is a SignFunc honoring the context, e.g for a remote call
+type SignContextFunc func(ctx context.Context, hash []byte) ([]byte, error)
+
+// FuncSigner adapts any signing backend, e.g a remote KMS or HSM, to the Signer interface.
+// The private key is never needed, only the EIP712 digest is handed to the callback.
+type FuncSigner struct {
+	address common.Address
+	sign    SignContextFunc
+}
+
+var _ ContextSigner = (*FuncSigner)(nil)
+var _ HashContextSigner = (*FuncSigner)(nil)
+
+// NewFuncSigner creates a signer which delegates signing to the callback
+//
+// @param address - address of the remote key
+//
+// @param sign - callback signing the EIP712 digest, the recovery id may be either 0/1 or 27/28
+//
+// @returns a FuncSigner
+func NewFuncSigner(address common.Address, sign SignFunc) *FuncSigner {
+	return NewContextFuncSigner(address, func(_ context.Context, hash []byte) ([]byte, error) {
+		return sign(hash)
+	})
+}
+
+// NewContextFuncSigner creates a signer which delegates signing to the callback,
+// the context of the signing calls is handed to the callback
+//
+// @param address - address of the remote key
+//
+// @param sign - callback signing the EIP712 digest, the recovery id may be either 0/1 or 27/28
+//
+// @returns a FuncSigner
+func NewContextFuncSigner(address common.Address, sign SignContextFunc) *FuncSigner {
+	return &FuncSigner{
+		address: address,
+		sign:    sign,
+	}
+}
+
+// GetAddress returns the address of the remote key
+func (s *FuncSigner) GetAddress() common.Address {
+	return s.address
+}
+
+// SignHash calls the callback with the hash, the recovery id of the signature is 27/28
+func (s *FuncSigner) SignHash(hashedData common.Hash) ([]byte, error) {
+	return s.SignHashContext(context.Background(), hashedData)
+}
+
+// SignHashContext calls the callback with the hash unless the context is done,
+// the recovery id of the signature is 27/28
+func (s *FuncSigner) SignHashContext(ctx context.Context, hashedData common.Hash) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	signature, err := s.sign(ctx, hashedData.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	// the result of a callback ignoring the context is dropped once it is done
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", model.ErrInvalidSignatureLen, crypto.SignatureLength, len(signature))
+	}
+
+	signature = common.CopyBytes(signature)
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		signature[crypto.RecoveryIDOffset] += 27
+	}
+
+	return signature, nil
+}
+
+// SignTypedData calls the callback with the EIP712 digest of the typed data
+func (s *FuncSigner) SignTypedData(typedData eip712.TypedData) ([]byte, error) {
+	return s.SignTypedDataContext(context.Background(), typedData)
+}
+
+// SignTypedDataContext calls the callback with the EIP712 digest of the typed data unless the context is done
+func (s *FuncSigner) SignTypedDataContext(ctx context.Context, typedData eip712.TypedData) ([]byte, error) {
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.SignHashContext(ctx, hash)
+}
@@ -0,0 +1,122 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuncSigner(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+	expected := NewPrivateKeySigner(privateKey)
+
+	hash := crypto.Keccak256Hash([]byte("hello"))
+	expectedSignature, err := expected.SignHash(hash)
+	assert.NoError(t, err)
+
+	// go-ethereum style recovery id 0/1
+	s := NewFuncSigner(signerAddress, func(digest []byte) ([]byte, error) {
+		return crypto.Sign(digest, privateKey)
+	})
+	assert.Equal(t, signerAddress, s.GetAddress())
+
+	signature, err := s.SignHash(hash)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSignature, signature)
+
+	// ethereum style recovery id 27/28
+	s = NewFuncSigner(signerAddress, func(digest []byte) ([]byte, error) {
+		assert.Equal(t, hash.Bytes(), digest)
+		return expectedSignature, nil
+	})
+	signature, err = s.SignHash(hash)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSignature, signature)
+}
+
+func TestFuncSignerSignTypedData(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+	expected := NewPrivateKeySigner(privateKey)
+
+	digest, err := TypedDataHash(mailTypedData)
+	assert.NoError(t, err)
+
+	s := NewFuncSigner(signerAddress, func(hash []byte) ([]byte, error) {
+		assert.Equal(t, digest.Bytes(), hash)
+		return crypto.Sign(hash, privateKey)
+	})
+
+	signature, err := s.SignTypedData(mailTypedData)
+	assert.NoError(t, err)
+
+	expectedSignature, err := expected.SignTypedData(mailTypedData)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSignature, signature)
+}
+
+func TestFuncSignerErrors(t *testing.T) {
+	remoteErr := errors.New("kms unavailable")
+	s := NewFuncSigner(signerAddress, func(hash []byte) ([]byte, error) {
+		return nil, remoteErr
+	})
+	_, err := s.SignTypedData(mailTypedData)
+	assert.ErrorIs(t, err, remoteErr)
+
+	s = NewFuncSigner(signerAddress, func(hash []byte) ([]byte, error) {
+		return make([]byte, 64), nil
+	})
+	_, err = s.SignTypedData(mailTypedData)
+	assert.ErrorIs(t, err, model.ErrInvalidSignatureLen)
+}
+
+func TestFuncSignerContext(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+	s := NewContextFuncSigner(signerAddress, func(ctx context.Context, hash []byte) ([]byte, error) {
+		assert.Equal(t, "request", ctx.Value(ctxKey{}))
+		return crypto.Sign(hash, privateKey)
+	})
+	signature, err := s.SignTypedDataContext(ctx, mailTypedData)
+	assert.NoError(t, err)
+
+	expectedSignature, err := NewPrivateKeySigner(privateKey).SignTypedData(mailTypedData)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSignature, signature)
+
+	// the callback is not called once the context is done
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	s = NewFuncSigner(signerAddress, func(hash []byte) ([]byte, error) {
+		t.Fatal("callback called with a cancelled context")
+		return nil, nil
+	})
+	_, err = s.SignTypedDataContext(cancelled, mailTypedData)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestSignTypedDataContext(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = NewPrivateKeySigner(privateKey).SignTypedDataContext(cancelled, mailTypedData)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = SignTypedDataContext(cancelled, NewPrivateKeySigner(privateKey), mailTypedData)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	signature, err := SignTypedDataContext(context.Background(), NewPrivateKeySigner(privateKey), mailTypedData)
+	assert.NoError(t, err)
+	assert.Len(t, signature, crypto.SignatureLength)
+}
@@ -0,0 +1,174 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/ethsig/eip712"
+)
+
+// KeystoreSigner signs with a key decrypted from a go-ethereum keystore.
+// The decrypted key is only held in memory and wiped by Close.
+type KeystoreSigner struct {
+	mu      sync.RWMutex
+	key     *keystore.Key
+	address common.Address
+}
+
+var _ ContextSigner = (*KeystoreSigner)(nil)
+var _ HashContextSigner = (*KeystoreSigner)(nil)
+
+// NewKeystoreSigner loads and decrypts the account from the keystore
+//
+// @param keystorePath - keystore directory, or a single keystore file
+//
+// @param passphrase - passphrase of the account
+//
+// @param address - address of the account to load
+//
+// @returns a KeystoreSigner, ErrWrongPassphrase if the passphrase is wrong
+// or ErrAccountNotFound if the address isn't in the keystore
+func NewKeystoreSigner(keystorePath, passphrase, address string) (*KeystoreSigner, error) {
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("can't parse address: %s as valid address", address)
+	}
+	account := common.HexToAddress(address)
+
+	keyJSON, err := findKeystoreFile(keystorePath, account)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		if errors.Is(err, keystore.ErrDecrypt) {
+			return nil, ErrWrongPassphrase
+		}
+		return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+	if key.Address != account {
+		zeroKey(key)
+		return nil, ErrAccountNotFound
+	}
+
+	return &KeystoreSigner{
+		key:     key,
+		address: account,
+	}, nil
+}
+
+// GetAddress returns the address of the keystore account
+func (s *KeystoreSigner) GetAddress() common.Address {
+	return s.address
+}
+
+// SignHash signs the hash, the recovery id of the signature is 27/28
+func (s *KeystoreSigner) SignHash(hashedData common.Hash) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.key == nil {
+		return nil, ErrSignerClosed
+	}
+
+	return signHash(hashedData, s.key.PrivateKey)
+}
+
+// SignHashContext signs the hash unless the context is done, the recovery id of the signature is 27/28
+func (s *KeystoreSigner) SignHashContext(ctx context.Context, hashedData common.Hash) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.SignHash(hashedData)
+}
+
+// SignTypedData signs the EIP712 digest of the typed data
+func (s *KeystoreSigner) SignTypedData(typedData eip712.TypedData) ([]byte, error) {
+	return s.SignTypedDataContext(context.Background(), typedData)
+}
+
+// SignTypedDataContext signs the EIP712 digest of the typed data unless the context is done
+func (s *KeystoreSigner) SignTypedDataContext(ctx context.Context, typedData eip712.TypedData) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.SignHash(hash)
+}
+
+// Close wipes the decrypted key from memory, signing afterwards returns ErrSignerClosed
+func (s *KeystoreSigner) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.key != nil {
+		zeroKey(s.key)
+		s.key = nil
+	}
+
+	return nil
+}
+
+func zeroKey(key *keystore.Key) {
+	if key.PrivateKey == nil {
+		return
+	}
+
+	b := key.PrivateKey.D.Bits()
+	for i := range b {
+		b[i] = 0
+	}
+	key.PrivateKey.D.SetInt64(0)
+	key.PrivateKey = nil
+}
+
+func findKeystoreFile(keystorePath string, account common.Address) ([]byte, error) {
+	info, err := os.Stat(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't read keystore: %w", err)
+	}
+	if !info.IsDir() {
+		return os.ReadFile(keystorePath)
+	}
+
+	entries, err := os.ReadDir(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't read keystore: %w", err)
+	}
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		keyJSON, err := os.ReadFile(filepath.Join(keystorePath, entry.Name()))
+		if err != nil {
+			continue
+		}
+
+		var header struct {
+			Address string `json:"address"`
+		}
+		if err := json.Unmarshal(keyJSON, &header); err != nil || !common.IsHexAddress(header.Address) {
+			continue
+		}
+		if common.HexToAddress(header.Address) == account {
+			return keyJSON, nil
+		}
+	}
+
+	return nil, ErrAccountNotFound
+}
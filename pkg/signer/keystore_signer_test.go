@@ -0,0 +1,95 @@
+package signer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeKeystore(t *testing.T, passphrase string) (string, string) {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         uuid.New(),
+		Address:    signerAddress,
+		PrivateKey: privateKey,
+	}, passphrase, keystore.LightScryptN, keystore.LightScryptP)
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "UTC--signer")
+	assert.NoError(t, os.WriteFile(file, keyJSON, 0600))
+	// unrelated files in the keystore directory are skipped
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("not a key"), 0600))
+
+	return dir, file
+}
+
+func TestNewKeystoreSigner(t *testing.T) {
+	dir, file := writeKeystore(t, "passphrase")
+
+	expected, err := NewPrivateKeySignerFromHex(privateKeyHex)
+	assert.NoError(t, err)
+	hash := crypto.Keccak256Hash([]byte("hello"))
+	expectedSignature, err := expected.SignHash(hash)
+	assert.NoError(t, err)
+
+	for _, path := range []string{dir, file} {
+		s, err := NewKeystoreSigner(path, "passphrase", signerAddress.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, signerAddress, s.GetAddress())
+
+		signature, err := s.SignHash(hash)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedSignature, signature)
+
+		signature, err = s.SignTypedData(mailTypedData)
+		assert.NoError(t, err)
+		expectedTypedSignature, err := expected.SignTypedData(mailTypedData)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTypedSignature, signature)
+	}
+}
+
+func TestNewKeystoreSignerErrors(t *testing.T) {
+	dir, file := writeKeystore(t, "passphrase")
+
+	_, err := NewKeystoreSigner(dir, "wrong", signerAddress.Hex())
+	assert.ErrorIs(t, err, ErrWrongPassphrase)
+
+	_, err = NewKeystoreSigner(dir, "passphrase", "0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	_, err = NewKeystoreSigner(file, "passphrase", "0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	_, err = NewKeystoreSigner(dir, "passphrase", "not an address")
+	assert.Error(t, err)
+
+	_, err = NewKeystoreSigner(filepath.Join(dir, "missing"), "passphrase", signerAddress.Hex())
+	assert.Error(t, err)
+}
+
+func TestKeystoreSignerClose(t *testing.T) {
+	dir, _ := writeKeystore(t, "passphrase")
+
+	s, err := NewKeystoreSigner(dir, "passphrase", signerAddress.Hex())
+	assert.NoError(t, err)
+
+	privateKey := s.key.PrivateKey
+	assert.NoError(t, s.Close())
+	assert.Zero(t, privateKey.D.Sign())
+
+	_, err = s.SignHash(crypto.Keccak256Hash([]byte("hello")))
+	assert.ErrorIs(t, err, ErrSignerClosed)
+
+	// closing twice is a no-op
+	assert.NoError(t, s.Close())
+	assert.Equal(t, signerAddress, s.GetAddress())
+}
@@ -0,0 +1,110 @@
+//go:build ledger
+
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/usbwallet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ivanzzeth/ethsig/eip712"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+)
+
+// LedgerSigner signs with an account of a Ledger device through go-ethereum's usbwallet.
+// The device is handed the EIP712 domain separator and struct hash, each signing
+// blocks until the user confirms or rejects it on the device.
+//
+// It is only built with the ledger build tag, the USB stack requires cgo.
+// Trezor devices can't sign EIP712 data through usbwallet and are not supported.
+type LedgerSigner struct {
+	wallet  accounts.Wallet
+	account accounts.Account
+}
+
+var _ Signer = (*LedgerSigner)(nil)
+
+// NewLedgerSigner opens the first connected Ledger device and derives the account from the path.
+// The Ethereum app must be running on the unlocked device.
+//
+// @param path - derivation path of the account, e.g accounts.DefaultBaseDerivationPath
+//
+// @returns a LedgerSigner, or ErrDeviceNotFound if no Ledger is connected
+func NewLedgerSigner(path accounts.DerivationPath) (*LedgerSigner, error) {
+	hub, err := usbwallet.NewLedgerHub()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open usb hub: %w", err)
+	}
+
+	wallets := hub.Wallets()
+	if len(wallets) == 0 {
+		return nil, ErrDeviceNotFound
+	}
+
+	wallet := wallets[0]
+	if err := wallet.Open(""); err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", wallet.URL(), mapDeviceError(err))
+	}
+
+	account, err := wallet.Derive(path, true)
+	if err != nil {
+		_ = wallet.Close()
+		return nil, fmt.Errorf("failed to derive %s: %w", path, mapDeviceError(err))
+	}
+
+	return &LedgerSigner{
+		wallet:  wallet,
+		account: account,
+	}, nil
+}
+
+// GetAddress returns the address of the derived account
+func (s *LedgerSigner) GetAddress() common.Address {
+	return s.account.Address
+}
+
+// SignTypedData asks the device to sign the typed data and waits for the user confirmation,
+// the recovery id of the signature is 27/28
+func (s *LedgerSigner) SignTypedData(typedData eip712.TypedData) ([]byte, error) {
+	rawData, err := typedDataEncoding(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := s.wallet.SignData(s.account, accounts.MimetypeTypedData, rawData)
+	if err != nil {
+		return nil, mapDeviceError(err)
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", model.ErrInvalidSignatureLen, crypto.SignatureLength, len(signature))
+	}
+
+	signature = common.CopyBytes(signature)
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		signature[crypto.RecoveryIDOffset] += 27
+	}
+
+	return signature, nil
+}
+
+// Close releases the device, signing afterwards returns ErrSignerClosed
+func (s *LedgerSigner) Close() error {
+	return s.wallet.Close()
+}
+
+// mapDeviceError maps the usbwallet errors to the errors of the package
+func mapDeviceError(err error) error {
+	switch {
+	case errors.Is(err, accounts.ErrWalletClosed):
+		return fmt.Errorf("%w: %v", ErrSignerClosed, err)
+	// usbwallet drops the status word of the device, a rejected request is a reply without signature
+	case strings.Contains(err.Error(), "reply lacks signature"):
+		return fmt.Errorf("%w: %v", ErrDeviceRejected, err)
+	default:
+		return err
+	}
+}
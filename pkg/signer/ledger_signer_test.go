@@ -0,0 +1,36 @@
+//go:build ledger
+
+package signer
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ivanzzeth/ethsig"
+	"github.com/stretchr/testify/assert"
+)
+
+// Drives a real device, run with a Ledger connected and unlocked on the Ethereum app:
+//
+//	LEDGER_TEST=1 go test -tags ledger -run TestLedgerSigner ./pkg/signer
+//
+// and confirm the signing on the device.
+func TestLedgerSigner(t *testing.T) {
+	if os.Getenv("LEDGER_TEST") == "" {
+		t.Skip("set LEDGER_TEST to sign with a connected Ledger")
+	}
+
+	s, err := NewLedgerSigner(accounts.DefaultBaseDerivationPath)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	signature, err := s.SignTypedData(mailTypedData)
+	assert.NoError(t, err)
+
+	hash, err := TypedDataHash(mailTypedData)
+	assert.NoError(t, err)
+	valid, err := ethsig.ValidateSignature(s.GetAddress(), hash, signature)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
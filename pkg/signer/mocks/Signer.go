@@ -0,0 +1,142 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	common "github.com/ethereum/go-ethereum/common"
+	eip712 "github.com/ivanzzeth/ethsig/eip712"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Signer is an autogenerated mock type for the Signer type
+type Signer struct {
+	mock.Mock
+}
+
+type Signer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Signer) EXPECT() *Signer_Expecter {
+	return &Signer_Expecter{mock: &_m.Mock}
+}
+
+// GetAddress provides a mock function with no fields
+func (_m *Signer) GetAddress() common.Address {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAddress")
+	}
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func() common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	return r0
+}
+
+// Signer_GetAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAddress'
+type Signer_GetAddress_Call struct {
+	*mock.Call
+}
+
+// GetAddress is a helper method to define mock.On call
+func (_e *Signer_Expecter) GetAddress() *Signer_GetAddress_Call {
+	return &Signer_GetAddress_Call{Call: _e.mock.On("GetAddress")}
+}
+
+func (_c *Signer_GetAddress_Call) Run(run func()) *Signer_GetAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Signer_GetAddress_Call) Return(_a0 common.Address) *Signer_GetAddress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Signer_GetAddress_Call) RunAndReturn(run func() common.Address) *Signer_GetAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignTypedData provides a mock function with given fields: typedData
+func (_m *Signer) SignTypedData(typedData eip712.TypedData) ([]byte, error) {
+	ret := _m.Called(typedData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignTypedData")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(eip712.TypedData) ([]byte, error)); ok {
+		return rf(typedData)
+	}
+	if rf, ok := ret.Get(0).(func(eip712.TypedData) []byte); ok {
+		r0 = rf(typedData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(eip712.TypedData) error); ok {
+		r1 = rf(typedData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Signer_SignTypedData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignTypedData'
+type Signer_SignTypedData_Call struct {
+	*mock.Call
+}
+
+// SignTypedData is a helper method to define mock.On call
+//   - typedData eip712.TypedData
+func (_e *Signer_Expecter) SignTypedData(typedData interface{}) *Signer_SignTypedData_Call {
+	return &Signer_SignTypedData_Call{Call: _e.mock.On("SignTypedData", typedData)}
+}
+
+func (_c *Signer_SignTypedData_Call) Run(run func(typedData eip712.TypedData)) *Signer_SignTypedData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(eip712.TypedData))
+	})
+	return _c
+}
+
+func (_c *Signer_SignTypedData_Call) Return(_a0 []byte, _a1 error) *Signer_SignTypedData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Signer_SignTypedData_Call) RunAndReturn(run func(eip712.TypedData) ([]byte, error)) *Signer_SignTypedData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSigner creates a new instance of Signer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSigner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Signer {
+	mock := &Signer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
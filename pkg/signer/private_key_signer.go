@@ -0,0 +1,86 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ivanzzeth/ethsig/eip712"
+)
+
+// PrivateKeySigner signs with a raw private key held in memory
+type PrivateKeySigner struct {
+	privateKey *ecdsa.PrivateKey
+	address    common.Address
+}
+
+var _ ContextSigner = (*PrivateKeySigner)(nil)
+var _ HashContextSigner = (*PrivateKeySigner)(nil)
+
+func NewPrivateKeySigner(privateKey *ecdsa.PrivateKey) *PrivateKeySigner {
+	return &PrivateKeySigner{
+		privateKey: privateKey,
+		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}
+}
+
+// NewPrivateKeySignerFromHex creates a signer from a hex encoded private key, with or without 0x prefix
+func NewPrivateKeySignerFromHex(hexKey string) (*PrivateKeySigner, error) {
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("can't parse private key: %w", err)
+	}
+
+	return NewPrivateKeySigner(privateKey), nil
+}
+
+// GetAddress returns the address of the private key
+func (s *PrivateKeySigner) GetAddress() common.Address {
+	return s.address
+}
+
+// SignHash signs the hash, the recovery id of the signature is 27/28
+func (s *PrivateKeySigner) SignHash(hashedData common.Hash) ([]byte, error) {
+	return signHash(hashedData, s.privateKey)
+}
+
+// SignHashContext signs the hash unless the context is done, the recovery id of the signature is 27/28
+func (s *PrivateKeySigner) SignHashContext(ctx context.Context, hashedData common.Hash) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.SignHash(hashedData)
+}
+
+// SignTypedData signs the EIP712 digest of the typed data
+func (s *PrivateKeySigner) SignTypedData(typedData eip712.TypedData) ([]byte, error) {
+	return s.SignTypedDataContext(context.Background(), typedData)
+}
+
+// SignTypedDataContext signs the EIP712 digest of the typed data unless the context is done
+func (s *PrivateKeySigner) SignTypedDataContext(ctx context.Context, typedData eip712.TypedData) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.SignHash(hash)
+}
+
+func signHash(hashedData common.Hash, privateKey *ecdsa.PrivateKey) ([]byte, error) {
+	signature, err := crypto.Sign(hashedData[:], privateKey)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+
+	return signature, nil
+}
@@ -0,0 +1,96 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ivanzzeth/ethsig/eip712"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// publicly known private key
+	privateKeyHex = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	// private key address
+	signerAddress = common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+	mailTypedData = eip712.TypedData{
+		Types: eip712.Types{
+			"EIP712Domain": []eip712.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"Mail": []eip712.Type{
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: eip712.TypedDataDomain{
+			Name:    "Test",
+			Version: "1",
+			ChainId: "137",
+		},
+		Message: eip712.TypedDataMessage{
+			"contents": "hello",
+		},
+	}
+)
+
+func TestNewPrivateKeySigner(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+
+	s := NewPrivateKeySigner(privateKey)
+	assert.Equal(t, signerAddress, s.GetAddress())
+
+	s, err = NewPrivateKeySignerFromHex(privateKeyHex)
+	assert.NoError(t, err)
+	assert.Equal(t, signerAddress, s.GetAddress())
+
+	s, err = NewPrivateKeySignerFromHex("0x" + privateKeyHex)
+	assert.NoError(t, err)
+	assert.Equal(t, signerAddress, s.GetAddress())
+
+	_, err = NewPrivateKeySignerFromHex("0x1234")
+	assert.Error(t, err)
+
+	_, err = NewPrivateKeySignerFromHex("not hex")
+	assert.Error(t, err)
+}
+
+func TestPrivateKeySignerSignHash(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+	s := NewPrivateKeySigner(privateKey)
+
+	hash := crypto.Keccak256Hash([]byte("hello"))
+
+	signature, err := s.SignHash(hash)
+	assert.NoError(t, err)
+
+	expected, err := crypto.Sign(hash[:], privateKey)
+	assert.NoError(t, err)
+
+	// same signature as go-ethereum, with an ethereum style recovery id
+	assert.Len(t, signature, 65)
+	assert.Equal(t, expected[:64], signature[:64])
+	assert.Equal(t, expected[64]+27, signature[64])
+}
+
+func TestPrivateKeySignerSignTypedData(t *testing.T) {
+	s, err := NewPrivateKeySignerFromHex(privateKeyHex)
+	assert.NoError(t, err)
+
+	signature, err := s.SignTypedData(mailTypedData)
+	assert.NoError(t, err)
+
+	hash, err := TypedDataHash(mailTypedData)
+	assert.NoError(t, err)
+
+	signature[64] -= 27
+	publicKey, err := crypto.SigToPub(hash[:], signature)
+	assert.NoError(t, err)
+	assert.Equal(t, signerAddress, crypto.PubkeyToAddress(*publicKey))
+}
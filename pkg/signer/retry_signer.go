@@ -0,0 +1,92 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/ethsig/eip712"
+)
+
+// RetrySigner retries the signing of the inner signer on error, e.g for a remote signer
+// returning transient errors.
+type RetrySigner struct {
+	inner       Signer
+	attempts    int
+	backoff     time.Duration
+	isTransient func(err error) bool
+}
+
+var _ ContextSigner = (*RetrySigner)(nil)
+
+// RetryOption configures a RetrySigner
+type RetryOption func(*RetrySigner)
+
+// WithRetryClassifier stops retrying on errors the classifier doesn't report as transient.
+// By default every error is retried.
+func WithRetryClassifier(isTransient func(err error) bool) RetryOption {
+	return func(s *RetrySigner) {
+		s.isTransient = isTransient
+	}
+}
+
+// WithRetry decorates the signer so a failed signing is retried
+//
+// @param inner - the signer to retry
+//
+// @param attempts - the maximum number of signing attempts, at least 1
+//
+// @param backoff - the delay between two attempts
+//
+// @returns a RetrySigner with the address of the inner signer
+func WithRetry(inner Signer, attempts int, backoff time.Duration, opts ...RetryOption) *RetrySigner {
+	s := &RetrySigner{
+		inner:    inner,
+		attempts: max(attempts, 1),
+		backoff:  backoff,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetAddress returns the address of the inner signer
+func (s *RetrySigner) GetAddress() common.Address {
+	return s.inner.GetAddress()
+}
+
+// SignTypedData signs with the inner signer, retrying on error
+func (s *RetrySigner) SignTypedData(typedData eip712.TypedData) ([]byte, error) {
+	return s.SignTypedDataContext(context.Background(), typedData)
+}
+
+// SignTypedDataContext signs with the inner signer, retrying on error until the context is done
+func (s *RetrySigner) SignTypedDataContext(ctx context.Context, typedData eip712.TypedData) ([]byte, error) {
+	var err error
+	for attempt := 1; ; attempt++ {
+		var signature []byte
+		signature, err = SignTypedDataContext(ctx, s.inner, typedData)
+		if err == nil {
+			return signature, nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		if s.isTransient != nil && !s.isTransient(err) {
+			return nil, err
+		}
+		if attempt == s.attempts {
+			return nil, fmt.Errorf("signing failed after %d attempts: %w", attempt, err)
+		}
+
+		timer := time.NewTimer(s.backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+var errTransient = errors.New("kms throttled")
+
+// flakySigner fails with errTransient until the given attempt
+func flakySigner(t *testing.T, succeedAt int, calls *int) *FuncSigner {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+
+	return NewFuncSigner(signerAddress, func(hash []byte) ([]byte, error) {
+		*calls++
+		if *calls < succeedAt {
+			return nil, errTransient
+		}
+		return crypto.Sign(hash, privateKey)
+	})
+}
+
+func TestWithRetry(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+	expectedSignature, err := NewPrivateKeySigner(privateKey).SignTypedData(mailTypedData)
+	assert.NoError(t, err)
+
+	calls := 0
+	s := WithRetry(flakySigner(t, 3, &calls), 3, time.Millisecond)
+	assert.Equal(t, signerAddress, s.GetAddress())
+
+	signature, err := s.SignTypedData(mailTypedData)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSignature, signature)
+	assert.Equal(t, 3, calls)
+
+	// out of attempts
+	calls = 0
+	s = WithRetry(flakySigner(t, 3, &calls), 2, time.Millisecond)
+	_, err = s.SignTypedData(mailTypedData)
+	assert.ErrorIs(t, err, errTransient)
+	assert.Equal(t, 2, calls)
+
+	// at least one attempt
+	calls = 0
+	s = WithRetry(flakySigner(t, 1, &calls), 0, time.Millisecond)
+	_, err = s.SignTypedData(mailTypedData)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWithRetryClassifier(t *testing.T) {
+	fatal := errors.New("key disabled")
+	calls := 0
+	s := WithRetry(NewFuncSigner(signerAddress, func(hash []byte) ([]byte, error) {
+		calls++
+		return nil, fatal
+	}), 5, time.Millisecond, WithRetryClassifier(func(err error) bool {
+		return errors.Is(err, errTransient)
+	}))
+
+	_, err := s.SignTypedData(mailTypedData)
+	assert.ErrorIs(t, err, fatal)
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	s = WithRetry(flakySigner(t, 3, &calls), 5, time.Millisecond, WithRetryClassifier(func(err error) bool {
+		return errors.Is(err, errTransient)
+	}))
+	_, err = s.SignTypedData(mailTypedData)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWithRetryContext(t *testing.T) {
+	calls := 0
+	s := WithRetry(flakySigner(t, 100, &calls), 100, time.Hour)
+
+	// the backoff is interrupted when the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := s.SignTypedDataContext(ctx, mailTypedData)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, calls)
+	assert.Less(t, time.Since(start), time.Minute)
+
+	// no attempt with a cancelled context
+	calls = 0
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = s.SignTypedDataContext(cancelled, mailTypedData)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, calls)
+}
@@ -0,0 +1,74 @@
+package signer
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/ethsig/eip712"
+)
+
+// SignerSelector is a Signer aggregating several backends, Next picks the backend of the next order.
+// The builder signs each order with the picked backend and uses its address for the order fields.
+type SignerSelector interface {
+	Signer
+
+	Next() Signer
+}
+
+// RoundRobinSigner rotates across several signers, e.g to spread the order volume over several EOAs.
+//
+// The aggregate has no address of its own: GetAddress returns the address of the first signer,
+// while the builder asks Next for the signer of each order and uses that signer's address
+// for the signer (and maker) fields. SignTypedData on the aggregate signs with the backend
+// matching the "signer" field of the message, so each signature always corresponds to the
+// address it claims, and only rotates for messages without a known signer.
+type RoundRobinSigner struct {
+	signers []Signer
+	next    atomic.Uint64
+}
+
+var _ SignerSelector = (*RoundRobinSigner)(nil)
+var _ ContextSigner = (*RoundRobinSigner)(nil)
+
+// NewRoundRobinSigner creates a signer rotating across the signers, it returns ErrNoSigners without signers
+func NewRoundRobinSigner(signers ...Signer) (*RoundRobinSigner, error) {
+	if len(signers) == 0 {
+		return nil, ErrNoSigners
+	}
+	return &RoundRobinSigner{signers: signers}, nil
+}
+
+// Next returns the next signer of the rotation, it is safe for concurrent use
+func (s *RoundRobinSigner) Next() Signer {
+	i := s.next.Add(1) - 1
+	return s.signers[i%uint64(len(s.signers))]
+}
+
+// GetAddress returns the address of the first signer, use Next for the signer of each call
+func (s *RoundRobinSigner) GetAddress() common.Address {
+	return s.signers[0].GetAddress()
+}
+
+// SignTypedData signs with the signer of the message, or the next signer of the rotation
+func (s *RoundRobinSigner) SignTypedData(typedData eip712.TypedData) ([]byte, error) {
+	return s.SignTypedDataContext(context.Background(), typedData)
+}
+
+// SignTypedDataContext signs with the signer of the message, or the next signer of the rotation,
+// unless the context is done
+func (s *RoundRobinSigner) SignTypedDataContext(ctx context.Context, typedData eip712.TypedData) ([]byte, error) {
+	return SignTypedDataContext(ctx, s.signerOf(typedData), typedData)
+}
+
+// signerOf returns the signer matching the "signer" field of the message, if any
+func (s *RoundRobinSigner) signerOf(typedData eip712.TypedData) Signer {
+	if address, ok := typedData.Message["signer"].(string); ok && common.IsHexAddress(address) {
+		for _, signer := range s.signers {
+			if signer.GetAddress() == common.HexToAddress(address) {
+				return signer
+			}
+		}
+	}
+	return s.Next()
+}
@@ -0,0 +1,56 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ivanzzeth/ethsig/eip712"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundRobinSigner(t *testing.T) {
+	firstKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+	secondKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	first, second := NewPrivateKeySigner(firstKey), NewPrivateKeySigner(secondKey)
+
+	s, err := NewRoundRobinSigner(first, second)
+	assert.NoError(t, err)
+	assert.Equal(t, first.GetAddress(), s.GetAddress())
+
+	assert.Equal(t, first, s.Next())
+	assert.Equal(t, second, s.Next())
+	assert.Equal(t, first, s.Next())
+
+	_, err = NewRoundRobinSigner()
+	assert.ErrorIs(t, err, ErrNoSigners)
+}
+
+func TestRoundRobinSignerSignTypedData(t *testing.T) {
+	firstKey, err := crypto.HexToECDSA(privateKeyHex)
+	assert.NoError(t, err)
+	secondKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	first, second := NewPrivateKeySigner(firstKey), NewPrivateKeySigner(secondKey)
+	s, err := NewRoundRobinSigner(first, second)
+	assert.NoError(t, err)
+
+	recoverSigner := func(typedData eip712.TypedData, signature []byte) common.Address {
+		hash, err := TypedDataHash(typedData)
+		assert.NoError(t, err)
+		signature = common.CopyBytes(signature)
+		signature[crypto.RecoveryIDOffset] -= 27
+		publicKey, err := crypto.SigToPub(hash.Bytes(), signature)
+		assert.NoError(t, err)
+		return crypto.PubkeyToAddress(*publicKey)
+	}
+
+	// messages without signer rotate
+	for _, expected := range []common.Address{first.GetAddress(), second.GetAddress(), first.GetAddress()} {
+		signature, err := s.SignTypedData(mailTypedData)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, recoverSigner(mailTypedData, signature))
+	}
+}
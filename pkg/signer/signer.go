@@ -0,0 +1,72 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ivanzzeth/ethsig"
+	"github.com/ivanzzeth/ethsig/eip712"
+)
+
+// Signer is an interface for signing hashed data
+//
+//go:generate mockery --name Signer --with-expecter
+type Signer interface {
+	ethsig.TypedDataSigner
+	ethsig.AddressGetter
+}
+
+// ContextSigner is a Signer whose signing can be cancelled through a context,
+// e.g when it reaches a remote backend. It returns ctx.Err() once the context is done.
+type ContextSigner interface {
+	Signer
+
+	SignTypedDataContext(ctx context.Context, typedData eip712.TypedData) ([]byte, error)
+}
+
+// HashContextSigner signs a precomputed EIP712 digest, so callers already holding
+// the digest don't hash the typed data once more. It returns ctx.Err() once the context is done.
+type HashContextSigner interface {
+	SignHashContext(ctx context.Context, hashedData common.Hash) ([]byte, error)
+}
+
+// SignTypedDataContext signs with the signer, honoring the context if the signer is a ContextSigner.
+// Other signers can't be interrupted, the context is only checked before signing.
+func SignTypedDataContext(ctx context.Context, s Signer, typedData eip712.TypedData) ([]byte, error) {
+	if cs, ok := s.(ContextSigner); ok {
+		return cs.SignTypedDataContext(ctx, typedData)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.SignTypedData(typedData)
+}
+
+// TypedDataHash computes the EIP712 digest of the typed data,
+// i.e keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message))
+func TypedDataHash(typedData eip712.TypedData) (common.Hash, error) {
+	rawData, err := typedDataEncoding(typedData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(rawData), nil
+}
+
+// typedDataEncoding returns the 66 bytes "\x19\x01" ‖ domainSeparator ‖ hashStruct(message) hashed into the digest
+func typedDataEncoding(typedData eip712.TypedData) ([]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash domain: %w", err)
+	}
+
+	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash message: %w", err)
+	}
+
+	return []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash))), nil
+}
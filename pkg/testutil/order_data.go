@@ -0,0 +1,156 @@
+// Package testutil provides fixtures to write tests against the order builder.
+// It is not imported by the other packages, so it is only linked into the test binaries
+// of the packages using it.
+package testutil
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/signer"
+	"github.com/shopspring/decimal"
+)
+
+// Well known development key (the first hardhat/anvil account), never use it to hold funds
+const PrivateKeyHex = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+// Address of PrivateKeyHex
+var Address = common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+// PrivateKey returns the private key of PrivateKeyHex
+func PrivateKey() *ecdsa.PrivateKey {
+	privateKey, err := crypto.HexToECDSA(PrivateKeyHex)
+	if err != nil {
+		panic(err)
+	}
+	return privateKey
+}
+
+// Signer returns a signer of PrivateKeyHex, the maker of the default order data
+func Signer() *signer.PrivateKeySigner {
+	return signer.NewPrivateKeySigner(PrivateKey())
+}
+
+type orderDataConfig struct {
+	data        model.OrderData
+	price, size decimal.Decimal
+	rawAmounts  bool
+}
+
+// OrderDataOption overrides a field of the order data built by NewOrderData
+type OrderDataOption func(*orderDataConfig)
+
+// WithMaker overrides the maker, Address by default
+func WithMaker(maker common.Address) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.data.Maker = maker.Hex()
+	}
+}
+
+// WithSigner overrides the signer, empty by default i.e the maker
+func WithSigner(signer common.Address) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.data.Signer = signer.Hex()
+	}
+}
+
+// WithTaker overrides the taker, empty by default i.e a public order
+func WithTaker(taker common.Address) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.data.Taker = taker.Hex()
+	}
+}
+
+// WithTokenId overrides the token id, "1234" by default
+func WithTokenId(tokenId string) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.data.TokenId = tokenId
+	}
+}
+
+// WithSide overrides the side, BUY by default. The amounts follow the side unless set by WithAmounts.
+func WithSide(side model.Side) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.data.Side = side
+	}
+}
+
+// WithPrice overrides the price and the size the amounts are calculated from, 0.5 and 100 shares by default
+func WithPrice(price, size string) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.price = decimal.RequireFromString(price)
+		c.size = decimal.RequireFromString(size)
+		c.rawAmounts = false
+	}
+}
+
+// WithAmounts sets the raw maker and taker amounts instead of calculating them from the price,
+// e.g to build invalid orders
+func WithAmounts(makerAmount, takerAmount string) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.data.MakerAmount = makerAmount
+		c.data.TakerAmount = takerAmount
+		c.rawAmounts = true
+	}
+}
+
+// WithFeeRateBps overrides the fee rate, "0" by default
+func WithFeeRateBps(feeRateBps string) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.data.FeeRateBps = feeRateBps
+	}
+}
+
+// WithNonce overrides the nonce, "0" by default
+func WithNonce(nonce string) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.data.Nonce = nonce
+	}
+}
+
+// WithExpiration overrides the expiration, empty by default i.e no expiration
+func WithExpiration(expiration string) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.data.Expiration = expiration
+	}
+}
+
+// WithSignatureType overrides the signature type, EOA by default
+func WithSignatureType(signatureType model.SignatureType) OrderDataOption {
+	return func(c *orderDataConfig) {
+		c.data.SignatureType = signatureType
+	}
+}
+
+// NewOrderData returns a valid order data, a BUY of 100 shares of token 1234 at 0.5 made by Address,
+// with the fields overridden by the options
+func NewOrderData(opts ...OrderDataOption) *model.OrderData {
+	c := &orderDataConfig{
+		data: model.OrderData{
+			Maker:         Address.Hex(),
+			TokenId:       "1234",
+			Side:          model.BUY,
+			FeeRateBps:    "0",
+			Nonce:         "0",
+			SignatureType: model.SignatureTypeEOA,
+		},
+		price: decimal.RequireFromString("0.5"),
+		size:  decimal.NewFromInt(100),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if !c.rawAmounts {
+		makerAmount, takerAmount, err := model.CalcOrderAmounts(c.data.Side, c.price, c.size)
+		if err != nil {
+			panic(err)
+		}
+		c.data.MakerAmount = makerAmount.String()
+		c.data.TakerAmount = takerAmount.String()
+	}
+
+	return &c.data
+}
@@ -0,0 +1,59 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ivanzzeth/polymarket-go-order-utils/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrderData(t *testing.T) {
+	assert.Equal(t, Address, Signer().GetAddress())
+
+	orderData := NewOrderData()
+	assert.NoError(t, model.ValidateOrderData(orderData))
+	assert.Equal(t, &model.OrderData{
+		Maker:       Address.Hex(),
+		TokenId:     "1234",
+		MakerAmount: "50000000",
+		TakerAmount: "100000000",
+		Side:        model.BUY,
+		FeeRateBps:  "0",
+		Nonce:       "0",
+	}, orderData)
+
+	// the amounts follow the side
+	orderData = NewOrderData(WithSide(model.SELL), WithPrice("0.25", "10"))
+	assert.NoError(t, model.ValidateOrderData(orderData))
+	assert.Equal(t, "10000000", orderData.MakerAmount)
+	assert.Equal(t, "2500000", orderData.TakerAmount)
+
+	wallet := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	orderData = NewOrderData(
+		WithMaker(wallet),
+		WithSigner(Address),
+		WithTaker(wallet),
+		WithTokenId("5678"),
+		WithSignatureType(model.SignatureTypePolyGnosisSafe),
+		WithFeeRateBps("100"),
+		WithNonce("2"),
+		WithExpiration("1735689600"),
+	)
+	assert.NoError(t, model.ValidateOrderData(orderData))
+	assert.Equal(t, wallet.Hex(), orderData.Maker)
+	assert.Equal(t, Address.Hex(), orderData.Signer)
+	assert.Equal(t, wallet.Hex(), orderData.Taker)
+	assert.Equal(t, "5678", orderData.TokenId)
+	assert.Equal(t, model.SignatureTypePolyGnosisSafe, orderData.SignatureType)
+	assert.Equal(t, "100", orderData.FeeRateBps)
+	assert.Equal(t, "2", orderData.Nonce)
+	assert.Equal(t, "1735689600", orderData.Expiration)
+
+	// raw amounts, e.g for invalid orders
+	orderData = NewOrderData(WithAmounts("100000000", "50000000"))
+	assert.ErrorIs(t, model.ValidateOrderData(orderData), model.ErrPriceOutOfRange)
+
+	// each call returns a new order data
+	assert.NotSame(t, NewOrderData(), NewOrderData())
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"math/big"
+	"sync/atomic"
+)
+
+// NonceManager tracks the order nonce of a maker, it is safe for concurrent use.
+//
+// The nonce is not what makes an order unique, the salt is. The exchange only fills orders
+// whose nonce equals the maker's current onchain nonce, and incrementNonce onchain cancels
+// every outstanding order at once. Sign orders with Current and call Next when the
+// onchain nonce is incremented.
+type NonceManager struct {
+	nonce atomic.Uint64
+}
+
+// NewNonceManager creates a nonce manager seeded with the current onchain nonce
+func NewNonceManager(start uint64) *NonceManager {
+	m := &NonceManager{}
+	m.nonce.Store(start)
+	return m
+}
+
+// Current returns the nonce to sign the orders with
+func (m *NonceManager) Current() *big.Int {
+	return new(big.Int).SetUint64(m.nonce.Load())
+}
+
+// Next increments the nonce, mirroring incrementNonce onchain, and returns the new nonce
+func (m *NonceManager) Next() *big.Int {
+	return new(big.Int).SetUint64(m.nonce.Add(1))
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNonceManager(t *testing.T) {
+	m := NewNonceManager(5)
+	assert.Equal(t, "5", m.Current().String())
+	assert.Equal(t, "5", m.Current().String())
+
+	assert.Equal(t, "6", m.Next().String())
+	assert.Equal(t, "7", m.Next().String())
+	assert.Equal(t, "7", m.Current().String())
+
+	assert.Equal(t, "0", NewNonceManager(0).Current().String())
+}
+
+func TestNonceManagerConcurrent(t *testing.T) {
+	m := NewNonceManager(0)
+
+	const goroutines = 16
+	const perGoroutine = 100
+
+	var mu sync.Mutex
+	seen := make(map[uint64]bool)
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perGoroutine; j++ {
+				nonce := m.Next().Uint64()
+				mu.Lock()
+				seen[nonce] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// every nonce is handed out exactly once
+	assert.Len(t, seen, goroutines*perGoroutine)
+	for i := uint64(1); i <= goroutines*perGoroutine; i++ {
+		assert.True(t, seen[i])
+	}
+	assert.Equal(t, uint64(goroutines*perGoroutine), m.Current().Uint64())
+}
@@ -2,12 +2,23 @@ package utils
 
 import (
 	"crypto/rand"
-	"math"
 	"math/big"
+	"time"
 )
 
+// GenerateRandomSalt draws a salt in the range of Polymarket's official clients, which multiply
+// the current timestamp by a random number (round(now * random()) in py-order-utils).
+// The salt is uniform in [1, the current unix time in seconds], about 1.7e9.
 func GenerateRandomSalt() int64 {
-	maxInt := math.Pow(2, 32)
-	nBig, _ := rand.Int(rand.Reader, big.NewInt(int64(maxInt)))
-	return nBig.Int64()
+	return GenerateRandomSaltUpTo(time.Now().Unix())
+}
+
+// GenerateRandomSaltUpTo draws a uniform salt in [1, max] from crypto/rand.
+// A max below 1 is treated as 1.
+func GenerateRandomSaltUpTo(max int64) int64 {
+	if max < 1 {
+		return 1
+	}
+	nBig, _ := rand.Int(rand.Reader, big.NewInt(max))
+	return nBig.Int64() + 1
 }
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -18,3 +19,25 @@ func TestGenerateRandomSalt(t *testing.T) {
 	assert.NotEqual(t, r1, r2)
 	assert.NotEqual(t, r2, r3)
 }
+
+func TestGenerateRandomSaltRange(t *testing.T) {
+	// the range of the official clients, up to the current unix time in seconds
+	upper := time.Now().Unix()
+	for i := 0; i < 10000; i++ {
+		salt := GenerateRandomSalt()
+		assert.GreaterOrEqual(t, salt, int64(1))
+		assert.LessOrEqual(t, salt, upper+1)
+	}
+
+	// custom ranges are inclusive
+	seen := map[int64]bool{}
+	for i := 0; i < 1000; i++ {
+		salt := GenerateRandomSaltUpTo(3)
+		assert.GreaterOrEqual(t, salt, int64(1))
+		assert.LessOrEqual(t, salt, int64(3))
+		seen[salt] = true
+	}
+	assert.Len(t, seen, 3)
+
+	assert.Equal(t, int64(1), GenerateRandomSaltUpTo(0))
+}